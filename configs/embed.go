@@ -0,0 +1,6 @@
+package configs
+
+import "embed"
+
+//go:embed global.yaml
+var FS embed.FS
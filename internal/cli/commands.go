@@ -20,16 +20,16 @@ func NewRootCommand() *cobra.Command {
 
 Примеры использования:
   # Простая генерация
-  gogen -d User -r User -uc CreateUser
+  gogen -d User -r User -u CreateUser
   
   # С тестами и моками
-  gogen -d Order -r Order -uc ProcessOrder -t -m
+  gogen -d Order -r Order -u ProcessOrder -t -m
   
   # Интерактивный режим
   gogen -d User --interactive
   
   # Множественная генерация
-  gogen -d User -d Product -d Order -r User -r Product -uc CreateOrder`,
+  gogen -d User -d Product -d Order -r User -r Product -u CreateOrder`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runGenerate(flags)
 		},
@@ -42,6 +42,10 @@ func NewRootCommand() *cobra.Command {
 	cmd.AddCommand(NewInitCommand())
 	cmd.AddCommand(NewVersionCommand())
 	cmd.AddCommand(NewInteractiveCommand())
+	cmd.AddCommand(NewApplyCommand())
+	cmd.AddCommand(NewImportCommand())
+	cmd.AddCommand(NewStatusCommand())
+	cmd.AddCommand(NewVerifyCommand())
 
 	return cmd
 }
@@ -68,14 +72,144 @@ func NewVersionCommand() *cobra.Command {
 }
 
 func NewInteractiveCommand() *cobra.Command {
-	return &cobra.Command{
+	flags := &Flags{}
+
+	cmd := &cobra.Command{
 		Use:   "interactive",
 		Short: "Запустить полностью интерактивный режим",
-		Long:  "Запускает интерактивный режим с пошаговым выбором компонентов",
+		Long: `Запускает мастер с пошаговым выбором компонентов: сущности (новые или уже
+существующие в проекте), поля, репозитории, use cases и HTTP handlers.
+Перед генерацией показывает список файлов и просит подтверждение.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFullInteractive(flags)
+		},
+	}
+
+	RegisterRunFlags(cmd, flags)
+
+	return cmd
+}
+
+func NewApplyCommand() *cobra.Command {
+	flags := &Flags{}
+	var specPath string
+
+	cmd := &cobra.Command{
+		Use:   "apply",
+		Short: "Сгенерировать компоненты по файлу спецификации",
+		Long: `Загружает план генерации из YAML или JSON спецификации и запускает генерацию.
+
+По умолчанию используется файл gogen.spec.yaml в корне проекта.
+
+Пример:
+  gogen apply -f gogen.spec.yaml --dry-run`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runApply(specPath, flags)
+		},
+	}
+
+	cmd.Flags().StringVarP(&specPath, "file", "f", "",
+		"Путь к файлу спецификации (YAML или JSON)")
+
+	RegisterRunFlags(cmd, flags)
+
+	return cmd
+}
+
+func NewStatusCommand() *cobra.Command {
+	flags := &Flags{}
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Показать состояние сгенерированных файлов",
+		Long:  "Сравнивает файлы из .gogen/manifest.json с их содержимым на диске и показывает изменённые вручную и удалённые файлы",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runFullInteractive()
+			return runStatus(flags)
 		},
 	}
+
+	cmd.Flags().StringVarP(&flags.OutputDir, "output", "o", "",
+		"Корневая директория проекта")
+
+	return cmd
+}
+
+func NewVerifyCommand() *cobra.Command {
+	flags := &Flags{}
+	var specPath string
+
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Проверить, что сгенерированный код соответствует спецификации",
+		Long: `Генерирует код по спецификации в памяти и сравнивает его с файлами на диске.
+
+Ничего не записывает. При расхождениях печатает unified diff и завершается
+с ненулевым кодом, поэтому подходит для CI.
+
+Пример:
+  gogen verify -f gogen.spec.yaml`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runVerify(specPath, flags)
+		},
+	}
+
+	cmd.Flags().StringVarP(&specPath, "file", "f", "",
+		"Путь к файлу спецификации (YAML или JSON)")
+	cmd.Flags().BoolVarP(&flags.WithTests, "with-tests", "t", false,
+		"Проверять тесты для всех компонентов")
+	cmd.Flags().BoolVarP(&flags.WithMocks, "with-mocks", "m", false,
+		"Проверять моки для репозиториев")
+	cmd.Flags().BoolVarP(&flags.Verbose, "verbose", "v", false,
+		"Подробный вывод")
+	cmd.Flags().BoolVarP(&flags.Quiet, "quiet", "q", false,
+		"Минимальный вывод")
+	cmd.Flags().StringVarP(&flags.OutputDir, "output", "o", "",
+		"Корневая директория проекта")
+
+	return cmd
+}
+
+func NewImportCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Импортировать компоненты из внешних источников",
+	}
+
+	cmd.AddCommand(NewImportSQLCommand())
+
+	return cmd
+}
+
+func NewImportSQLCommand() *cobra.Command {
+	flags := &Flags{}
+	var nullStyle string
+
+	cmd := &cobra.Command{
+		Use:   "sql <schema.sql>",
+		Short: "Сгенерировать сущности и репозитории по CREATE TABLE",
+		Long: `Разбирает CREATE TABLE и CREATE INDEX из SQL файла (Postgres, MySQL, SQLite)
+и генерирует сущность и репозиторий для каждой таблицы.
+
+Колонки id, created_at и updated_at пропускаются: они уже есть в шаблоне сущности.
+Тип колонки id (или составной PRIMARY KEY) определяет id_strategy сущности.
+Колонка deleted_at включает soft_delete, пара created_by/updated_by — audit.
+
+Пример:
+  gogen import sql migrations/001_init.sql --db mysql --null sql`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runImportSQL(args[0], nullStyle, flags)
+		},
+	}
+
+	cmd.Flags().StringVar(&flags.DBType, "db", "postgres",
+		"Диалект SQL схемы: postgres | mysql | sqlite")
+	cmd.Flags().StringVar(&nullStyle, "null", "pointer",
+		"Представление nullable колонок: pointer | sql")
+
+	RegisterRunFlags(cmd, flags)
+
+	return cmd
 }
 
 func runInit() error {
@@ -98,7 +232,19 @@ func runInit() error {
 # paths:
 #   domain: "internal/domain"
 #   repository: "internal/repository"
+#   inmemory: "internal/repository/inmemory"
 #   usecase: "internal/usecase"
+#   migrations: "migrations"
+
+# Формат SQL миграций (опционально)
+# migrations:
+#   format: "goose"  # golang-migrate | goose | none
+
+# Декораторы use case с logging/metrics (опционально)
+# observability:
+#   logging: "slog"  # slog | none
+#   metrics: "prometheus"  # prometheus | none
+#   tracing: "otel"  # otel | none
 
 # Переопределение стиля именования (опционально)
 # naming:
@@ -121,8 +267,3 @@ func runInit() error {
 
 	return nil
 }
-
-func runFullInteractive() error {
-
-	return fmt.Errorf("полностью интерактивный режим будет реализован")
-}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
+
+	"gogen/internal/dialect"
+	"gogen/pkg/models"
 )
 
 type Flags struct {
@@ -11,31 +14,55 @@ type Flags struct {
 	Repositories []string
 	UseCases     []string
 	Handlers     []string
+	CRUD         []string
 
 	WithTests   bool
 	WithMocks   bool
 	Interactive bool
 	Force       bool
 	DryRun      bool
+	ShowDiff    bool
+	ShowContent bool
 
 	Verbose bool
 	Quiet   bool
 	NoColor bool
 
+	DBType     string
+	IDStrategy string
+	SoftDelete bool
+	Audit      bool
+
 	ConfigPath string
 	OutputDir  string
 }
 
 func RegisterFlags(cmd *cobra.Command, flags *Flags) {
 
-	cmd.Flags().StringSliceVarP(&flags.Entities, "entity", "d", []string{},
+	cmd.Flags().StringArrayVarP(&flags.Entities, "entity", "d", []string{},
 		"Создать сущность (можно указать несколько раз)")
 	cmd.Flags().StringSliceVarP(&flags.Repositories, "repo", "r", []string{},
 		"Создать репозиторий (можно указать несколько раз)")
-	cmd.Flags().StringSliceVarP(&flags.UseCases, "usecase", "uc", []string{},
+	cmd.Flags().StringSliceVarP(&flags.UseCases, "usecase", "u", []string{},
 		"Создать use case (можно указать несколько раз)")
 	cmd.Flags().StringSliceVar(&flags.Handlers, "handler", []string{},
 		"Создать HTTP handler (можно указать несколько раз)")
+	cmd.Flags().StringArrayVar(&flags.CRUD, "crud", []string{},
+		"Создать сущность, репозиторий, CRUD use cases, handler и тесты")
+
+	cmd.Flags().StringVar(&flags.DBType, "db", "postgres",
+		"Тип базы данных для репозиториев: postgres | mysql | sqlite | mongodb")
+	cmd.Flags().StringVar(&flags.IDStrategy, "id-strategy", "",
+		"Тип первичного ключа сущностей: uuid | ulid | int64 | string")
+	cmd.Flags().BoolVar(&flags.SoftDelete, "soft-delete", false,
+		"Мягкое удаление сущностей (deleted_at, Restore, HardDelete)")
+	cmd.Flags().BoolVar(&flags.Audit, "audit", false,
+		"Добавить поля аудита created_by/updated_by")
+
+	RegisterRunFlags(cmd, flags)
+}
+
+func RegisterRunFlags(cmd *cobra.Command, flags *Flags) {
 
 	cmd.Flags().BoolVarP(&flags.WithTests, "with-tests", "t", false,
 		"Генерировать тесты для всех компонентов")
@@ -47,6 +74,10 @@ func RegisterFlags(cmd *cobra.Command, flags *Flags) {
 		"Перезаписывать существующие файлы без подтверждения")
 	cmd.Flags().BoolVar(&flags.DryRun, "dry-run", false,
 		"Показать что будет создано без реального создания файлов")
+	cmd.Flags().BoolVar(&flags.ShowDiff, "diff", false,
+		"В dry-run режиме показать unified diff с существующими файлами")
+	cmd.Flags().BoolVar(&flags.ShowContent, "show-content", false,
+		"В dry-run режиме показать полное содержимое файлов")
 
 	cmd.Flags().BoolVarP(&flags.Verbose, "verbose", "v", false,
 		"Подробный вывод")
@@ -66,15 +97,39 @@ func (f *Flags) Validate() error {
 	if len(f.Entities) == 0 &&
 		len(f.Repositories) == 0 &&
 		len(f.UseCases) == 0 &&
-		len(f.Handlers) == 0 {
+		len(f.Handlers) == 0 &&
+		len(f.CRUD) == 0 {
 
 		return nil
 	}
 
+	if err := f.ValidateRun(); err != nil {
+		return err
+	}
+
+	switch dialect.Normalize(f.DBType) {
+	case dialect.Postgres, dialect.MySQL, dialect.SQLite, dialect.MongoDB:
+	default:
+		return fmt.Errorf("unsupported --db value: %s", f.DBType)
+	}
+
+	if strategy, ok := models.ParseIDStrategy(f.IDStrategy); !ok || strategy == models.IDComposite {
+		return fmt.Errorf("unsupported --id-strategy value: %s", f.IDStrategy)
+	}
+
+	return nil
+}
+
+func (f *Flags) ValidateRun() error {
+
 	if f.Quiet && f.Verbose {
 		return fmt.Errorf("--quiet and --verbose cannot be used together")
 	}
 
+	if (f.ShowDiff || f.ShowContent) && !f.DryRun {
+		return fmt.Errorf("--diff and --show-content require --dry-run")
+	}
+
 	return nil
 }
 
@@ -82,5 +137,6 @@ func (f *Flags) HasComponents() bool {
 	return len(f.Entities) > 0 ||
 		len(f.Repositories) > 0 ||
 		len(f.UseCases) > 0 ||
-		len(f.Handlers) > 0
+		len(f.Handlers) > 0 ||
+		len(f.CRUD) > 0
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"gogen/internal/dialect"
 	"gogen/internal/parser"
 	"gogen/internal/util"
 	"gogen/pkg/models"
@@ -25,7 +26,7 @@ func (p *Parser) BuildPlan(flags *Flags) (*models.GenerationPlan, error) {
 		WithMocks: flags.WithMocks,
 	}
 
-	for _, entityName := range flags.Entities {
+	for _, entityName := range entityArgs(flags.Entities) {
 		entity, err := p.parseEntity(entityName)
 		if err != nil {
 			return nil, fmt.Errorf("ошибка парсинга сущности %s: %w", entityName, err)
@@ -34,7 +35,7 @@ func (p *Parser) BuildPlan(flags *Flags) (*models.GenerationPlan, error) {
 	}
 
 	for _, repoName := range flags.Repositories {
-		repo, err := p.parseRepository(repoName)
+		repo, err := p.parseRepository(repoName, flags.DBType)
 		if err != nil {
 			return nil, fmt.Errorf("ошибка парсинга репозитория %s: %w", repoName, err)
 		}
@@ -49,9 +50,86 @@ func (p *Parser) BuildPlan(flags *Flags) (*models.GenerationPlan, error) {
 		plan.UseCases = append(plan.UseCases, uc)
 	}
 
+	for _, handlerName := range flags.Handlers {
+		handler, err := p.parseHandler(handlerName)
+		if err != nil {
+			return nil, fmt.Errorf("ошибка парсинга handler %s: %w", handlerName, err)
+		}
+		plan.Handlers = append(plan.Handlers, handler)
+	}
+
+	for _, crudArg := range entityArgs(flags.CRUD) {
+		if err := p.addCRUD(plan, crudArg, flags.DBType); err != nil {
+			return nil, fmt.Errorf("ошибка парсинга CRUD %s: %w", crudArg, err)
+		}
+	}
+
 	return plan, nil
 }
 
+func (p *Parser) addCRUD(plan *models.GenerationPlan, input, dbType string) error {
+	entity, err := p.parseEntity(input)
+	if err != nil {
+		return err
+	}
+
+	plan.WithTests = true
+
+	if !plan.HasEntity(entity.Name) {
+		plan.Entities = append(plan.Entities, entity)
+	}
+
+	if !plan.HasRepository(entity.Name) {
+		repo, err := p.parseRepository(entity.Name, dbType)
+		if err != nil {
+			return err
+		}
+		plan.Repositories = append(plan.Repositories, repo)
+	}
+
+	for _, action := range models.CRUDActions {
+		uc, err := p.parseUseCase(action + entity.Name)
+		if err != nil {
+			return err
+		}
+		if plan.GetUseCaseByName(uc.Name) != nil {
+			continue
+		}
+		uc.CRUD = action
+		plan.UseCases = append(plan.UseCases, uc)
+	}
+
+	for _, handler := range plan.Handlers {
+		if handler.Name == entity.Name {
+			return nil
+		}
+	}
+
+	handler, err := p.parseHandler(entity.Name)
+	if err != nil {
+		return err
+	}
+	plan.Handlers = append(plan.Handlers, handler)
+
+	return nil
+}
+
+func entityArgs(args []string) []string {
+	var result []string
+	for _, arg := range args {
+		if strings.Contains(arg, ":") {
+			result = append(result, arg)
+			continue
+		}
+		for _, name := range strings.Split(arg, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				result = append(result, name)
+			}
+		}
+	}
+	return result
+}
+
 func (p *Parser) parseEntity(input string) (models.EntityConfig, error) {
 
 	parts := strings.SplitN(input, ":", 2)
@@ -81,7 +159,7 @@ func (p *Parser) parseEntity(input string) (models.EntityConfig, error) {
 	return entity, nil
 }
 
-func (p *Parser) parseRepository(input string) (models.RepositoryConfig, error) {
+func (p *Parser) parseRepository(input, dbType string) (models.RepositoryConfig, error) {
 	name := strings.TrimSpace(input)
 
 	name = strings.TrimSuffix(name, "Repository")
@@ -94,7 +172,7 @@ func (p *Parser) parseRepository(input string) (models.RepositoryConfig, error)
 		Name:             name,
 		Entity:           name,
 		TableName:        util.ToSnakeCase(util.Pluralize(name)),
-		DBType:           "postgres",
+		DBType:           dialect.Normalize(dbType),
 		WithTransactions: true,
 		AddComments:      true,
 	}
@@ -124,3 +202,21 @@ func (p *Parser) parseUseCase(input string) (models.UseCaseConfig, error) {
 
 	return uc, nil
 }
+
+func (p *Parser) parseHandler(input string) (models.HandlerConfig, error) {
+	name := strings.TrimSpace(input)
+
+	name = strings.TrimSuffix(name, "Handler")
+
+	if err := util.ValidatePascalCase(name); err != nil {
+		return models.HandlerConfig{}, err
+	}
+
+	handler := models.HandlerConfig{
+		Name:        name,
+		Route:       util.ToRoute(util.Pluralize(name)),
+		AddComments: true,
+	}
+
+	return handler, nil
+}
@@ -3,47 +3,53 @@ package cli
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
+	"sort"
 
 	"gogen/internal/config"
 	"gogen/internal/dependency"
+	"gogen/internal/dialect"
 	"gogen/internal/file"
 	"gogen/internal/format"
 	"gogen/internal/generator"
 	"gogen/internal/interactive"
 	"gogen/internal/logger"
+	"gogen/internal/manifest"
+	"gogen/internal/migration"
+	"gogen/internal/parser"
 	"gogen/internal/project"
+	"gogen/internal/spec"
 	"gogen/internal/template"
 	"gogen/internal/util"
 	"gogen/pkg/models"
 )
 
-func runGenerate(flags *Flags) error {
-
-	if err := flags.Validate(); err != nil {
-		return err
-	}
-
-	if !flags.HasComponents() && !flags.Interactive {
-
-		return runFullInteractive()
-	}
+type environment struct {
+	finder     *project.Finder
+	root       string
+	modulePath string
+	cfg        *models.Config
+	log        *logger.Logger
+	reporter   *logger.Reporter
+}
 
+func newEnvironment(flags *Flags) (*environment, error) {
 	finder := project.NewFinder(flags.OutputDir)
 	root, err := finder.FindRoot()
 	if err != nil {
-		return fmt.Errorf("не удалось найти корень проекта: %w\nПопробуйте запустить 'gogen init'", err)
+		return nil, fmt.Errorf("не удалось найти корень проекта: %w\nПопробуйте запустить 'gogen init'", err)
 	}
 
 	modulePath, err := finder.GetModulePath()
 	if err != nil {
-		return fmt.Errorf("не удалось получить module path: %w", err)
+		return nil, fmt.Errorf("не удалось получить module path: %w", err)
 	}
 
 	configLoader := config.NewLoader(root)
 	cfg, err := configLoader.Load()
 	if err != nil {
-		return fmt.Errorf("не удалось загрузить конфигурацию: %w", err)
+		return nil, fmt.Errorf("не удалось загрузить конфигурацию: %w", err)
 	}
 
 	logLevel := logger.LevelInfo
@@ -55,9 +61,33 @@ func runGenerate(flags *Flags) error {
 	}
 
 	log := logger.NewLogger(logLevel, true)
-	defer log.Close()
 
-	reporter := logger.NewReporter(log)
+	return &environment{
+		finder:     finder,
+		root:       root,
+		modulePath: modulePath,
+		cfg:        cfg,
+		log:        log,
+		reporter:   logger.NewReporter(log),
+	}, nil
+}
+
+func runGenerate(flags *Flags) error {
+
+	if err := flags.Validate(); err != nil {
+		return err
+	}
+
+	if !flags.HasComponents() && !flags.Interactive {
+
+		return runFullInteractive(flags)
+	}
+
+	env, err := newEnvironment(flags)
+	if err != nil {
+		return err
+	}
+	defer env.log.Close()
 
 	parser := NewParser()
 	plan, err := parser.BuildPlan(flags)
@@ -65,29 +95,167 @@ func runGenerate(flags *Flags) error {
 		return fmt.Errorf("ошибка парсинга аргументов: %w", err)
 	}
 
-	plan.ModulePath = modulePath
-	plan.ProjectRoot = root
+	return executePlan(env, plan, flags)
+}
+
+func runApply(specPath string, flags *Flags) error {
+
+	if err := flags.ValidateRun(); err != nil {
+		return err
+	}
+
+	env, err := newEnvironment(flags)
+	if err != nil {
+		return err
+	}
+	defer env.log.Close()
+
+	plan, err := loadSpecPlan(env, specPath, flags)
+	if err != nil {
+		return err
+	}
+
+	return executePlan(env, plan, flags)
+}
+
+func loadSpecPlan(env *environment, specPath string, flags *Flags) (*models.GenerationPlan, error) {
+	if specPath == "" {
+		specPath = filepath.Join(env.root, spec.DefaultFileName)
+	}
+
+	s, err := spec.Load(specPath)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := spec.NewBuilder().BuildPlan(s)
+	if err != nil {
+		return nil, err
+	}
+
+	plan.WithTests = plan.WithTests || flags.WithTests
+	plan.WithMocks = plan.WithMocks || flags.WithMocks
+
+	env.log.Debug("Загружена спецификация: %s", specPath)
+
+	return plan, nil
+}
+
+func runImportSQL(schemaPath, nullStyle string, flags *Flags) error {
+
+	if err := flags.ValidateRun(); err != nil {
+		return err
+	}
+
+	if !dialect.IsSQL(flags.DBType) {
+		return fmt.Errorf("unsupported --db value for SQL import: %s", flags.DBType)
+	}
+
+	if nullStyle != parser.NullPointer && nullStyle != parser.NullSQL {
+		return fmt.Errorf("unsupported --null value: %s", nullStyle)
+	}
+
+	env, err := newEnvironment(flags)
+	if err != nil {
+		return err
+	}
+	defer env.log.Close()
+
+	data, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return fmt.Errorf("не удалось прочитать схему: %w", err)
+	}
+
+	entities, err := parser.NewDDLParser(flags.DBType, nullStyle).ParseEntities(string(data))
+	if err != nil {
+		return fmt.Errorf("ошибка разбора схемы %s: %w", schemaPath, err)
+	}
+
+	plan := &models.GenerationPlan{
+		WithTests: flags.WithTests,
+		WithMocks: flags.WithMocks,
+	}
+
+	for _, entity := range entities {
+		plan.Entities = append(plan.Entities, entity)
+		plan.Repositories = append(plan.Repositories, models.RepositoryConfig{
+			Name:             entity.Name,
+			Entity:           entity.Name,
+			TableName:        entity.TableName,
+			DBType:           dialect.Normalize(flags.DBType),
+			WithTransactions: true,
+			AddComments:      true,
+			SkipMigration:    true,
+		})
+	}
+
+	env.log.Debug("Импортировано таблиц: %d", len(entities))
+
+	return executePlan(env, plan, flags)
+}
+
+func executePlan(env *environment, plan *models.GenerationPlan, flags *Flags) error {
+	if err := preparePlan(env, plan, flags); err != nil {
+		return err
+	}
+
+	if flags.DryRun {
+		return runDryRun(env, plan, flags)
+	}
+
+	return writePlan(env, plan, flags)
+}
+
+func preparePlan(env *environment, plan *models.GenerationPlan, flags *Flags) error {
+	plan.ModulePath = env.modulePath
+	plan.ProjectRoot = env.root
 
 	if flags.Interactive {
-		interactor := interactive.NewInteractor(log)
-		if err := interactor.EnhancePlan(plan, cfg); err != nil {
+		interactor := interactive.NewInteractor(env.log)
+		if err := interactor.EnhancePlan(plan, env.cfg); err != nil {
 			return fmt.Errorf("ошибка интерактивного режима: %w", err)
 		}
 	}
 
+	strategy := flags.IDStrategy
+	if strategy == "" {
+		strategy = env.cfg.Generation.IDStrategy
+	}
+	for i := range plan.Entities {
+		if plan.Entities[i].IDStrategy == "" {
+			plan.Entities[i].IDStrategy = models.IDStrategy(strategy)
+		}
+		if flags.SoftDelete {
+			plan.Entities[i].SoftDelete = true
+		}
+		if flags.Audit {
+			plan.Entities[i].Audit = true
+		}
+	}
+
 	detector := dependency.NewDetector()
 	resolver := dependency.NewResolver(detector)
+	resolver.SetEntityLoader(func(name string) ([]models.Field, error) {
+		return loadEntityFields(env, name)
+	})
 
 	if err := resolver.Resolve(plan); err != nil {
 		return fmt.Errorf("не удалось разрешить зависимости: %w", err)
 	}
 
-	if err := finder.EnsureStructure(cfg); err != nil {
-		return fmt.Errorf("не удалось создать структуру папок: %w", err)
-	}
+	detectExistingEntities(env, plan)
 
-	if flags.DryRun {
-		return runDryRun(plan, reporter)
+	return nil
+}
+
+func writePlan(env *environment, plan *models.GenerationPlan, flags *Flags) error {
+	root := env.root
+	cfg := env.cfg
+	log := env.log
+	reporter := env.reporter
+
+	if err := env.finder.EnsureStructure(cfg); err != nil {
+		return fmt.Errorf("не удалось создать структуру папок: %w", err)
 	}
 
 	writer := file.NewWriter(root)
@@ -117,6 +285,8 @@ func runGenerate(flags *Flags) error {
 		}
 	}
 
+	writer.AllowOverwrite(conflicts...)
+
 	templateLoader := template.NewLoader(root, cfg)
 	renderer := template.NewRenderer(templateLoader)
 	formatter := format.NewFormatter()
@@ -124,6 +294,12 @@ func runGenerate(flags *Flags) error {
 
 	gen := generator.NewGenerator(renderer, writer, formatter, importsManager, cfg)
 
+	m, err := manifest.Load(root)
+	if err != nil {
+		return err
+	}
+	gen.UseManifest(m)
+
 	reporter.ReportStart(plan)
 
 	ctx := context.Background()
@@ -141,40 +317,300 @@ func runGenerate(flags *Flags) error {
 		return err
 	}
 
+	if err := m.Save(root); err != nil {
+		log.Warn("Не удалось сохранить манифест: %v", err)
+	}
+
 	reporter.ReportComplete(plan, writer.GetWrittenFiles())
 
 	return nil
 }
 
-func runDryRun(plan *models.GenerationPlan, reporter *logger.Reporter) error {
-	fmt.Println("🔍 Dry-run режим - показываем что будет создано:\n")
+func runFullInteractive(flags *Flags) error {
 
-	reporter.ReportStart(plan)
+	if err := flags.ValidateRun(); err != nil {
+		return err
+	}
 
-	fmt.Println("\n📋 Будут созданы следующие файлы:\n")
+	env, err := newEnvironment(flags)
+	if err != nil {
+		return err
+	}
+	defer env.log.Close()
 
-	for _, entity := range plan.Entities {
-		fmt.Printf("  📄 internal/domain/%s.go\n", util.ToSnakeCase(entity.Name))
-		if plan.WithTests {
-			fmt.Printf("  📄 internal/domain/%s_test.go\n", util.ToSnakeCase(entity.Name))
+	m, err := manifest.Load(env.root)
+	if err != nil {
+		return err
+	}
+
+	wizard := interactive.NewWizard(env.log, project.NewAnalyzer(env.finder), m)
+
+	plan, err := wizard.Run(env.cfg)
+	if err != nil {
+		return fmt.Errorf("ошибка интерактивного режима: %w", err)
+	}
+
+	plan.WithTests = plan.WithTests || flags.WithTests
+	plan.WithMocks = plan.WithMocks || flags.WithMocks
+
+	if err := preparePlan(env, plan, flags); err != nil {
+		return err
+	}
+
+	files, err := renderInMemory(env, plan)
+	if err != nil {
+		return err
+	}
+
+	printFileList(files)
+	fmt.Println()
+
+	if flags.DryRun {
+		return nil
+	}
+
+	if err := wizard.Confirm(plan); err != nil {
+		return err
+	}
+
+	flags.Interactive = true
+
+	return writePlan(env, plan, flags)
+}
+
+func runVerify(specPath string, flags *Flags) error {
+
+	if err := flags.ValidateRun(); err != nil {
+		return err
+	}
+
+	env, err := newEnvironment(flags)
+	if err != nil {
+		return err
+	}
+	defer env.log.Close()
+
+	plan, err := loadSpecPlan(env, specPath, flags)
+	if err != nil {
+		return err
+	}
+
+	if err := preparePlan(env, plan, flags); err != nil {
+		return err
+	}
+
+	files, err := renderInMemory(env, plan)
+	if err != nil {
+		return err
+	}
+
+	drifted := 0
+	for _, f := range files {
+		if !f.Changed() {
+			env.log.Debug("✓ %s", f.Path)
+			continue
 		}
+
+		drifted++
+		fmt.Print(f.Diff())
 	}
 
-	for _, repo := range plan.Repositories {
-		fmt.Printf("  📄 internal/domain/%s_repository.go (интерфейс)\n", util.ToSnakeCase(repo.Name))
-		fmt.Printf("  📄 internal/repository/%s_repository.go (реализация)\n", util.ToSnakeCase(repo.Name))
-		if plan.WithTests {
-			fmt.Printf("  📄 internal/repository/%s_repository_test.go\n", util.ToSnakeCase(repo.Name))
+	if drifted > 0 {
+		return fmt.Errorf("сгенерированный код расходится со спецификацией: файлов с изменениями: %d\nЗапустите 'gogen apply --force' для регенерации", drifted)
+	}
+
+	if !flags.Quiet {
+		fmt.Printf("✓ Сгенерированный код соответствует спецификации (%d файлов)\n", len(files))
+	}
+
+	return nil
+}
+
+func runStatus(flags *Flags) error {
+	env, err := newEnvironment(flags)
+	if err != nil {
+		return err
+	}
+	defer env.log.Close()
+
+	m, err := manifest.Load(env.root)
+	if err != nil {
+		return err
+	}
+
+	if len(m.Files) == 0 {
+		fmt.Println("Манифест пуст: gogen ещё ничего не сгенерировал")
+		return nil
+	}
+
+	statuses, err := m.Status(env.root)
+	if err != nil {
+		return err
+	}
+
+	for _, status := range statuses {
+		switch status.State {
+		case manifest.StateModified:
+			fmt.Printf("  ✏️  %s (изменён вручную)\n", status.Entry.Path)
+		case manifest.StateMissing:
+			fmt.Printf("  ❌ %s (удалён)\n", status.Entry.Path)
+		default:
+			fmt.Printf("  ✓ %s\n", status.Entry.Path)
 		}
-		if plan.WithMocks {
-			fmt.Printf("  📄 internal/mocks/%s_repository_mock.go\n", util.ToSnakeCase(repo.Name))
+	}
+
+	return nil
+}
+
+func detectExistingEntities(env *environment, plan *models.GenerationPlan) {
+	analyzer := project.NewAnalyzer(env.finder)
+
+	m, err := manifest.Load(env.root)
+	if err != nil {
+		env.log.Debug("Не удалось загрузить манифест: %v", err)
+		m = manifest.New()
+	}
+
+	for i := range plan.Entities {
+		entity := &plan.Entities[i]
+		path := filepath.Join(env.cfg.Paths.Domain, util.ToSnakeCase(entity.Name)+".go")
+
+		if !analyzer.FileExists(path) {
+			continue
+		}
+
+		fields, err := analyzer.ExtractStructFields(path, entity.Name)
+		if err != nil {
+			env.log.Debug("Не удалось разобрать %s: %v", path, err)
+			continue
 		}
+
+		entity.Existing = true
+		entity.PreviousFields = fields
+		if previous, ok := m.Entity(entity.Name); ok {
+			entity.Previous = &previous
+		}
+
+		diff := migration.EntityDiff(entity)
+		env.log.Info("Сущность %s уже существует, изменения полей: +%d -%d ~%d",
+			entity.Name, len(diff.Added), len(diff.Removed), len(diff.Changed))
 	}
+}
 
-	for _, uc := range plan.UseCases {
-		fmt.Printf("  📄 internal/usecase/%s_usecase.go\n", util.ToSnakeCase(uc.Name))
-		if plan.WithTests {
-			fmt.Printf("  📄 internal/usecase/%s_usecase_test.go\n", util.ToSnakeCase(uc.Name))
+func loadEntityFields(env *environment, name string) ([]models.Field, error) {
+	analyzer := project.NewAnalyzer(env.finder)
+
+	path := filepath.Join(env.cfg.Paths.Domain, util.ToSnakeCase(name)+".go")
+	fields, err := analyzer.ExtractStructFields(path, name)
+	if err != nil {
+		return nil, fmt.Errorf("связанная сущность %s не найдена: %w", name, err)
+	}
+
+	return fields, nil
+}
+
+type renderedFile struct {
+	Path     string
+	Content  string
+	Existing string
+	Exists   bool
+}
+
+func (f renderedFile) Changed() bool {
+	return !f.Exists || f.Existing != f.Content
+}
+
+func (f renderedFile) Diff() string {
+	from := "a/" + f.Path
+	if !f.Exists {
+		from = file.DevNull
+	}
+	return file.Diff(from, "b/"+f.Path, f.Existing, f.Content)
+}
+
+func renderInMemory(env *environment, plan *models.GenerationPlan) ([]renderedFile, error) {
+	writer := file.NewMemoryWriter(env.root)
+	renderer := template.NewRenderer(template.NewLoader(env.root, env.cfg))
+
+	gen := generator.NewGenerator(renderer, writer, format.NewFormatter(), format.NewImportsManager(), env.cfg)
+	if err := gen.Generate(context.Background(), plan); err != nil {
+		return nil, fmt.Errorf("ошибка генерации: %w", err)
+	}
+
+	contents := writer.Contents()
+
+	files := make([]renderedFile, 0, len(contents))
+	for path, content := range contents {
+		relative, err := filepath.Rel(env.root, path)
+		if err != nil {
+			relative = path
+		}
+
+		f := renderedFile{
+			Path:    filepath.ToSlash(relative),
+			Content: content,
+		}
+
+		data, err := os.ReadFile(path)
+		switch {
+		case err == nil:
+			f.Exists = true
+			f.Existing = string(data)
+		case !os.IsNotExist(err):
+			return nil, fmt.Errorf("не удалось прочитать %s: %w", f.Path, err)
+		}
+
+		files = append(files, f)
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+
+	return files, nil
+}
+
+func printFileList(files []renderedFile) {
+	fmt.Print("\n📋 Файлы:\n\n")
+
+	for _, f := range files {
+		switch {
+		case !f.Exists:
+			fmt.Printf("  📄 %s (новый)\n", f.Path)
+		case f.Changed():
+			fmt.Printf("  ✏️  %s (изменён)\n", f.Path)
+		default:
+			fmt.Printf("  ✓ %s (без изменений)\n", f.Path)
+		}
+	}
+}
+
+func runDryRun(env *environment, plan *models.GenerationPlan, flags *Flags) error {
+	fmt.Print("🔍 Dry-run режим - показываем что будет создано:\n\n")
+
+	env.reporter.ReportStart(plan)
+
+	files, err := renderInMemory(env, plan)
+	if err != nil {
+		return err
+	}
+
+	printFileList(files)
+
+	for _, handler := range plan.Handlers {
+		fmt.Printf("\n  🌐 %sHandler:\n", handler.Name)
+		for _, endpoint := range handler.Endpoints {
+			fmt.Printf("      %s %s → %sUseCase\n", endpoint.Method, endpoint.Path, endpoint.UseCase)
+		}
+	}
+
+	for _, f := range files {
+		if flags.ShowDiff && f.Changed() {
+			fmt.Println()
+			fmt.Print(f.Diff())
+		}
+		if flags.ShowContent {
+			fmt.Printf("\n━━━ %s ━━━\n%s", f.Path, f.Content)
 		}
 	}
 
@@ -189,19 +625,52 @@ func collectExpectedFiles(plan *models.GenerationPlan, cfg *models.Config) []str
 	root := plan.ProjectRoot
 
 	for _, entity := range plan.Entities {
+		if entity.Existing {
+			continue
+		}
 		fileName := util.ToSnakeCase(entity.Name) + ".go"
 		files = append(files, filepath.Join(root, cfg.Paths.Domain, fileName))
+
+		if plan.WithTests {
+			testName := util.ToSnakeCase(entity.Name) + "_test.go"
+			files = append(files, filepath.Join(root, cfg.Paths.Domain, testName))
+		}
 	}
 
 	for _, repo := range plan.Repositories {
+		if entity := plan.GetEntityByName(repo.Entity); entity != nil && entity.Existing {
+			continue
+		}
 		fileName := util.ToSnakeCase(repo.Name) + "_repository.go"
 		files = append(files, filepath.Join(root, cfg.Paths.Domain, fileName))
 		files = append(files, filepath.Join(root, cfg.Paths.Repository, fileName))
+		files = append(files, filepath.Join(root, cfg.Paths.InMemory, fileName))
+
+		if plan.WithMocks {
+			mockName := util.ToSnakeCase(repo.Name) + "_repository_mock.go"
+			files = append(files, filepath.Join(root, cfg.Paths.Mocks, mockName))
+		}
+
+		if plan.WithTests {
+			testName := util.ToSnakeCase(repo.Name) + "_repository_test.go"
+			files = append(files, filepath.Join(root, cfg.Paths.Repository, testName))
+			files = append(files, filepath.Join(root, cfg.Paths.InMemory, testName))
+		}
 	}
 
 	for _, uc := range plan.UseCases {
 		fileName := util.ToSnakeCase(uc.Name) + "_usecase.go"
 		files = append(files, filepath.Join(root, cfg.Paths.UseCase, fileName))
+
+		if plan.WithTests {
+			testName := util.ToSnakeCase(uc.Name) + "_usecase_test.go"
+			files = append(files, filepath.Join(root, cfg.Paths.UseCase, testName))
+		}
+	}
+
+	for _, handler := range plan.Handlers {
+		fileName := util.ToSnakeCase(handler.Name) + "_handler.go"
+		files = append(files, filepath.Join(root, cfg.Paths.Handler, fileName))
 	}
 
 	return files
@@ -1,18 +1,16 @@
 package config
 
 import (
-	"embed"
 	"fmt"
 	"os"
 	"path/filepath"
 
+	"gogen/configs"
 	"gogen/pkg/models"
 
 	"gopkg.in/yaml.v3"
 )
 
-var globalConfigFS embed.FS
-
 type Loader struct {
 	projectRoot string
 }
@@ -45,7 +43,7 @@ func (l *Loader) Load() (*models.Config, error) {
 }
 
 func (l *Loader) loadGlobalConfig() (*models.Config, error) {
-	data, err := globalConfigFS.ReadFile("global.yaml")
+	data, err := configs.FS.ReadFile("global.yaml")
 	if err != nil {
 		return nil, err
 	}
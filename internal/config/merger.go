@@ -25,6 +25,12 @@ func (l *Loader) mergeConfigs(global, user *models.Config) *models.Config {
 
 	result.Imports = l.mergeImports(global.Imports, user.Imports)
 
+	if user.Migrations.Format != "" {
+		result.Migrations.Format = user.Migrations.Format
+	}
+
+	result.Observability = l.mergeObservability(global.Observability, user.Observability)
+
 	return &result
 }
 
@@ -43,12 +49,18 @@ func (l *Loader) mergePaths(global, user models.Paths) models.Paths {
 	if user.Handler != "" {
 		result.Handler = user.Handler
 	}
+	if user.InMemory != "" {
+		result.InMemory = user.InMemory
+	}
 	if user.Mocks != "" {
 		result.Mocks = user.Mocks
 	}
 	if user.Tests != "" {
 		result.Tests = user.Tests
 	}
+	if user.Migrations != "" {
+		result.Migrations = user.Migrations
+	}
 
 	return result
 }
@@ -93,12 +105,57 @@ func (l *Loader) mergeTemplates(global, user models.Templates) models.Templates
 	if user.RepositoryImpl != "" {
 		result.RepositoryImpl = user.RepositoryImpl
 	}
+	if user.RepositoryImplMongo != "" {
+		result.RepositoryImplMongo = user.RepositoryImplMongo
+	}
 	if user.UseCase != "" {
 		result.UseCase = user.UseCase
 	}
 	if user.Handler != "" {
 		result.Handler = user.Handler
 	}
+	if user.HandlerResponse != "" {
+		result.HandlerResponse = user.HandlerResponse
+	}
+	if user.Validation != "" {
+		result.Validation = user.Validation
+	}
+	if user.Audit != "" {
+		result.Audit = user.Audit
+	}
+	if user.Errors != "" {
+		result.Errors = user.Errors
+	}
+	if user.RepositoryInMemory != "" {
+		result.RepositoryInMemory = user.RepositoryInMemory
+	}
+	if user.InMemory != "" {
+		result.InMemory = user.InMemory
+	}
+	if user.TestInMemory != "" {
+		result.TestInMemory = user.TestInMemory
+	}
+	if user.Observability != "" {
+		result.Observability = user.Observability
+	}
+	if user.RepositoryErrors != "" {
+		result.RepositoryErrors = user.RepositoryErrors
+	}
+	if user.UseCaseErrors != "" {
+		result.UseCaseErrors = user.UseCaseErrors
+	}
+	if user.Pagination != "" {
+		result.Pagination = user.Pagination
+	}
+	if user.Transaction != "" {
+		result.Transaction = user.Transaction
+	}
+	if user.TxManager != "" {
+		result.TxManager = user.TxManager
+	}
+	if user.TxManagerMock != "" {
+		result.TxManagerMock = user.TxManagerMock
+	}
 	if user.Mock != "" {
 		result.Mock = user.Mock
 	}
@@ -121,6 +178,28 @@ func (l *Loader) mergeGeneration(global, user models.Generation) models.Generati
 	if user.ErrorHandling != "" {
 		result.ErrorHandling = user.ErrorHandling
 	}
+	if user.Validation != "" {
+		result.Validation = user.Validation
+	}
+	if user.IDStrategy != "" {
+		result.IDStrategy = user.IDStrategy
+	}
+
+	return result
+}
+
+func (l *Loader) mergeObservability(global, user models.Observability) models.Observability {
+	result := global
+
+	if user.Logging != "" {
+		result.Logging = user.Logging
+	}
+	if user.Metrics != "" {
+		result.Metrics = user.Metrics
+	}
+	if user.Tracing != "" {
+		result.Tracing = user.Tracing
+	}
 
 	return result
 }
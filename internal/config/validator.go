@@ -1 +1,39 @@
-package config
\ No newline at end of file
+package config
+
+import (
+	"fmt"
+
+	"gogen/internal/migration"
+	"gogen/pkg/models"
+)
+
+func (l *Loader) validateConfig(cfg *models.Config) error {
+	required := []struct {
+		name  string
+		value string
+	}{
+		{"domain", cfg.Paths.Domain},
+		{"repository", cfg.Paths.Repository},
+		{"usecase", cfg.Paths.UseCase},
+		{"handler", cfg.Paths.Handler},
+	}
+	for _, path := range required {
+		if path.value == "" {
+			return fmt.Errorf("paths.%s is required", path.name)
+		}
+	}
+
+	switch cfg.Naming.Style {
+	case "", "pascal_case", "snake_case", "camel_case":
+	default:
+		return fmt.Errorf("unsupported naming.style: %s", cfg.Naming.Style)
+	}
+
+	switch cfg.Migrations.Format {
+	case "", migration.FormatGolangMigrate, migration.FormatGoose, migration.FormatNone:
+	default:
+		return fmt.Errorf("unsupported migrations.format: %s", cfg.Migrations.Format)
+	}
+
+	return nil
+}
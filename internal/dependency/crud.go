@@ -0,0 +1,68 @@
+package dependency
+
+import (
+	"fmt"
+
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+func (r *Resolver) resolveCRUD(plan *models.GenerationPlan) error {
+	for i := range plan.UseCases {
+		uc := &plan.UseCases[i]
+		if uc.CRUD == "" {
+			continue
+		}
+
+		name := r.detector.extractEntityFromUseCaseName(uc.Name)
+		entity := plan.GetEntityByName(name)
+		if entity == nil {
+			return fmt.Errorf("use case %s: entity %s not found", uc.Name, name)
+		}
+
+		key := entity.PrimaryKey()
+		if key.Composite() {
+			return fmt.Errorf("use case %s: crud use cases do not support composite keys", uc.Name)
+		}
+
+		id := models.Field{Name: "ID", Type: key.Type(), JSONTag: "id"}
+		item := models.Field{Name: entity.Name, Type: "*" + entity.Name, JSONTag: util.ToSnakeCase(entity.Name)}
+
+		switch uc.CRUD {
+		case models.CRUDCreate:
+			uc.InputFields = nil
+			if entity.IDStrategy == models.IDString {
+				uc.InputFields = append(uc.InputFields, id)
+			}
+			uc.InputFields = append(uc.InputFields, entity.Fields...)
+			uc.OutputFields = []models.Field{item}
+		case models.CRUDGet:
+			uc.InputFields = []models.Field{id}
+			uc.OutputFields = []models.Field{item}
+		case models.CRUDUpdate:
+			uc.InputFields = append([]models.Field{id}, entity.Fields...)
+			if entity.Versioned {
+				uc.InputFields = append(uc.InputFields, models.VersionField())
+			}
+			uc.OutputFields = []models.Field{item}
+		case models.CRUDDelete:
+			uc.InputFields = []models.Field{id}
+			uc.OutputFields = nil
+		case models.CRUDList:
+			uc.InputFields = []models.Field{
+				{Name: "SortBy", Type: "string", JSONTag: "sort_by"},
+				{Name: "Asc", Type: "bool", JSONTag: "asc"},
+				{Name: "Limit", Type: "int", JSONTag: "limit"},
+				{Name: "Cursor", Type: "string", JSONTag: "cursor"},
+			}
+			uc.OutputFields = []models.Field{
+				{Name: "Items", Type: "[]*" + entity.Name, JSONTag: "items"},
+				{Name: "NextCursor", Type: "string", JSONTag: "next_cursor"},
+			}
+		default:
+			return fmt.Errorf("use case %s: unknown crud action %s", uc.Name, uc.CRUD)
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package dependency
 import (
 	"strings"
 
+	"gogen/internal/util"
 	"gogen/pkg/models"
 )
 
@@ -48,6 +49,11 @@ func (d *Detector) DetectUseCaseDependencies(uc *models.UseCaseConfig, plan *mod
 }
 
 func (d *Detector) extractEntityFromUseCaseName(name string) string {
+	_, entityName := d.splitUseCaseName(name)
+	return entityName
+}
+
+func (d *Detector) splitUseCaseName(name string) (action, entity string) {
 
 	name = strings.TrimSuffix(name, "UseCase")
 
@@ -61,12 +67,88 @@ func (d *Detector) extractEntityFromUseCaseName(name string) string {
 		if strings.HasPrefix(name, prefix) {
 			entityName := strings.TrimPrefix(name, prefix)
 			if entityName != "" {
-				return entityName
+				return prefix, entityName
 			}
 		}
 	}
 
-	return ""
+	return "", ""
+}
+
+func (d *Detector) DetectHandlerEndpoints(handler *models.HandlerConfig, plan *models.GenerationPlan) []models.Endpoint {
+	var endpoints []models.Endpoint
+
+	route := strings.TrimSuffix(handler.Route, "/")
+
+	if handler.UseCase != "" {
+		uc := plan.GetUseCaseByName(strings.TrimSuffix(handler.UseCase, "UseCase"))
+		if uc == nil {
+			return nil
+		}
+
+		endpoint := d.endpointForUseCase(uc.Name, handler.Name, route)
+		if handler.Method != "" {
+			endpoint.Method = strings.ToUpper(handler.Method)
+			endpoint.Path = handler.Route
+		}
+
+		return append(endpoints, endpoint)
+	}
+
+	for _, uc := range plan.UseCases {
+		_, entityName := d.splitUseCaseName(uc.Name)
+
+		matches := entityName == handler.Name ||
+			entityName == util.Pluralize(handler.Name) ||
+			(entityName == "" && strings.HasSuffix(uc.Name, handler.Name))
+
+		if !matches {
+			continue
+		}
+
+		endpoints = append(endpoints, d.endpointForUseCase(uc.Name, handler.Name, route))
+	}
+
+	return endpoints
+}
+
+func (d *Detector) endpointForUseCase(ucName, entityName, route string) models.Endpoint {
+	action, _ := d.splitUseCaseName(ucName)
+
+	endpoint := models.Endpoint{UseCase: ucName}
+
+	switch action {
+	case "Create", "Add", "Register":
+		endpoint.Method = "POST"
+		endpoint.Path = route
+	case "Get", "Find", "Fetch":
+		endpoint.Method = "GET"
+		endpoint.Path = route + "/{id}"
+	case "List", "Search":
+		endpoint.Method = "GET"
+		endpoint.Path = route
+	case "Update":
+		endpoint.Method = "PUT"
+		endpoint.Path = route + "/{id}"
+	case "Delete", "Remove":
+		endpoint.Method = "DELETE"
+		endpoint.Path = route + "/{id}"
+	case "Login", "Logout":
+		endpoint.Method = "POST"
+		endpoint.Path = route + "/" + util.ToKebabCase(action)
+	default:
+		if action == "" {
+			action = strings.TrimSuffix(ucName, entityName)
+		}
+		endpoint.Method = "POST"
+		if action == ucName {
+			endpoint.Path = route + "/" + util.ToKebabCase(action)
+		} else {
+			endpoint.Path = route + "/{id}/" + util.ToKebabCase(action)
+		}
+	}
+
+	return endpoint
 }
 
 func (d *Detector) DetectMissingDependencies(plan *models.GenerationPlan) map[string][]string {
@@ -0,0 +1,34 @@
+package dependency
+
+import (
+	"fmt"
+	"strings"
+
+	"gogen/pkg/models"
+)
+
+func (r *Resolver) resolveEnums(plan *models.GenerationPlan) error {
+	declared := make(map[string]string)
+
+	for i := range plan.Entities {
+		entity := &plan.Entities[i]
+		for j := range entity.Fields {
+			field := &entity.Fields[j]
+			if !field.IsEnum() {
+				continue
+			}
+
+			if field.BaseType() == "enum" {
+				field.Type = strings.TrimSuffix(field.Type, "enum") + entity.Name + field.Name
+			}
+
+			owner := entity.Name + "." + field.Name
+			if previous, ok := declared[field.BaseType()]; ok {
+				return fmt.Errorf("enum %s is declared by both %s and %s", field.BaseType(), previous, owner)
+			}
+			declared[field.BaseType()] = owner
+		}
+	}
+
+	return nil
+}
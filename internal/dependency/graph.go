@@ -10,6 +10,7 @@ import (
 type Graph struct {
 	nodes map[string]*Node
 	edges map[string][]string
+	order []string
 }
 
 type Node struct {
@@ -26,6 +27,9 @@ func NewGraph() *Graph {
 }
 
 func (g *Graph) AddNode(name string, nodeType models.ComponentType, component models.Component) {
+	if _, ok := g.nodes[name]; !ok {
+		g.order = append(g.order, name)
+	}
 	g.nodes[name] = &Node{
 		Name:      name,
 		Type:      nodeType,
@@ -47,6 +51,26 @@ func (g *Graph) BuildFromPlan(plan *models.GenerationPlan) {
 		g.AddNode(entity.Name, models.ComponentTypeEntity, entity)
 	}
 
+	for i := range plan.Entities {
+		entity := &plan.Entities[i]
+		for _, rel := range entity.Relations {
+			if rel.Entity == entity.Name {
+				continue
+			}
+
+			switch rel.Kind {
+			case models.RelationBelongsTo:
+				g.AddEdge(entity.Name, rel.Entity)
+			case models.RelationHasMany:
+				g.AddEdge(rel.Entity, entity.Name)
+			case models.RelationManyToMany:
+				if !rel.Inverse {
+					g.AddEdge(entity.Name, rel.Entity)
+				}
+			}
+		}
+	}
+
 	for i := range plan.Repositories {
 		repo := &plan.Repositories[i]
 		repoName := repo.Name + "Repository"
@@ -96,7 +120,7 @@ func (g *Graph) TopologicalSort() ([]string, error) {
 		return nil
 	}
 
-	for node := range g.nodes {
+	for _, node := range g.order {
 		if !visited[node] {
 			if err := visit(node); err != nil {
 				return nil, err
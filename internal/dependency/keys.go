@@ -0,0 +1,117 @@
+package dependency
+
+import (
+	"fmt"
+
+	"gogen/internal/dialect"
+	"gogen/pkg/models"
+)
+
+func (r *Resolver) resolveKeys(plan *models.GenerationPlan) error {
+	for i := range plan.Entities {
+		entity := &plan.Entities[i]
+
+		strategy, ok := models.ParseIDStrategy(string(entity.IDStrategy))
+		if !ok {
+			return fmt.Errorf("entity %s: unknown id strategy: %s", entity.Name, entity.IDStrategy)
+		}
+		entity.IDStrategy = strategy
+	}
+
+	for _, repo := range plan.Repositories {
+		entity := plan.GetEntityByName(repo.Entity)
+		if entity == nil || dialect.Normalize(repo.DBType) != dialect.MongoDB {
+			continue
+		}
+
+		switch entity.IDStrategy {
+		case models.IDInt64, models.IDComposite:
+			return fmt.Errorf("repository %s: %s ids are not supported by mongodb", repo.Name, entity.IDStrategy)
+		}
+	}
+
+	return nil
+}
+
+func (r *Resolver) checkKeys(plan *models.GenerationPlan) error {
+	for i := range plan.Entities {
+		if err := checkKey(&plan.Entities[i]); err != nil {
+			return fmt.Errorf("entity %s: %w", plan.Entities[i].Name, err)
+		}
+	}
+	return nil
+}
+
+func checkKey(entity *models.EntityConfig) error {
+	if entity.IDStrategy != models.IDComposite {
+		if len(entity.Key) > 0 {
+			return fmt.Errorf("key fields require the %s id strategy", models.IDComposite)
+		}
+		return nil
+	}
+
+	if len(entity.Key) == 0 {
+		return fmt.Errorf("composite key needs at least one field")
+	}
+
+	key := entity.PrimaryKey()
+	for _, name := range entity.Key {
+		found := false
+		for _, field := range key.Fields {
+			if field.Name == name || field.DBTag == name {
+				found = true
+				if field.IsPointer() {
+					return fmt.Errorf("key field %s cannot be nullable", field.Name)
+				}
+			}
+		}
+		if !found {
+			return fmt.Errorf("key field %s not found", name)
+		}
+	}
+
+	return nil
+}
+
+func (r *Resolver) keyType(name string, plan *models.GenerationPlan) (string, error) {
+	if target := plan.GetEntityByName(name); target != nil {
+		key := target.PrimaryKey()
+		if key.Composite() {
+			return "", fmt.Errorf("%s has a composite key and cannot be referenced", name)
+		}
+		return key.Type(), nil
+	}
+
+	fields, err := r.loadEntity(name)
+	if err != nil || fields == nil {
+		return models.IDUUID.GoType(), err
+	}
+
+	for _, field := range fields {
+		if field.DBTag == "id" {
+			return field.Type, nil
+		}
+	}
+	return "", fmt.Errorf("%s has no id column and cannot be referenced", name)
+}
+
+func (r *Resolver) loadEntity(name string) ([]models.Field, error) {
+	if r.loader == nil {
+		return nil, nil
+	}
+	if fields, ok := r.loaded[name]; ok {
+		return fields, nil
+	}
+
+	fields, err := r.loader(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.loaded == nil {
+		r.loaded = make(map[string][]models.Field)
+	}
+	r.loaded[name] = fields
+
+	return fields, nil
+}
@@ -0,0 +1,260 @@
+package dependency
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+func (r *Resolver) resolveRelations(plan *models.GenerationPlan) error {
+	for i := range plan.Entities {
+		if err := r.expandRelations(&plan.Entities[i], plan); err != nil {
+			return fmt.Errorf("entity %s: %w", plan.Entities[i].Name, err)
+		}
+	}
+
+	for i := range plan.Entities {
+		entity := &plan.Entities[i]
+		for j := range entity.Relations {
+			rel := &entity.Relations[j]
+			if target := plan.GetEntityByName(rel.Entity); target != nil {
+				rel.TargetFields = target.Schema().Columns
+				continue
+			}
+
+			fields, err := r.loadEntity(rel.Entity)
+			if err != nil {
+				return fmt.Errorf("entity %s: %w", entity.Name, err)
+			}
+			rel.TargetFields = fields
+		}
+	}
+
+	for i := range plan.Repositories {
+		addRelationMethods(&plan.Repositories[i], plan)
+	}
+
+	return nil
+}
+
+func (r *Resolver) expandRelations(entity *models.EntityConfig, plan *models.GenerationPlan) error {
+	fields := make([]models.Field, 0, len(entity.Fields))
+	key := entity.PrimaryKey()
+
+	for _, field := range entity.Fields {
+		if field.Relation == "" {
+			fields = append(fields, field)
+			continue
+		}
+
+		rel := models.Relation{
+			Name:   field.Name,
+			Kind:   field.Relation,
+			Entity: relationTarget(field.Type),
+		}
+		if entity.GetRelation(rel.Name) != nil {
+			return fmt.Errorf("relation %s is declared twice", rel.Name)
+		}
+
+		targetType, err := r.keyType(rel.Entity, plan)
+		if err != nil {
+			return fmt.Errorf("relation %s: %w", rel.Name, err)
+		}
+		if key.Composite() && rel.Kind != models.RelationBelongsTo {
+			return fmt.Errorf("relation %s: %s relations need an id column on %s", rel.Name, rel.Kind, entity.Name)
+		}
+
+		rel.Table = util.ToSnakeCase(util.Pluralize(rel.Entity))
+		target := plan.GetEntityByName(rel.Entity)
+		if target != nil && target.TableName != "" {
+			rel.Table = target.TableName
+		}
+
+		switch rel.Kind {
+		case models.RelationBelongsTo:
+			rel.ForeignKey = util.ToSnakeCase(field.Name) + "_id"
+			rel.Nullable = strings.HasPrefix(field.Type, "*")
+			rel.KeyType = targetType
+
+			column := models.Field{
+				Name:     rel.KeyField(),
+				Type:     targetType,
+				JSONTag:  rel.ForeignKey,
+				DBTag:    rel.ForeignKey,
+				Comment:  field.Comment,
+				Required: field.Required,
+				Index:    !field.Unique,
+				Unique:   field.Unique,
+			}
+			if rel.Nullable {
+				column.Type = "*" + targetType
+			}
+			fields = append(fields, column)
+
+		case models.RelationHasMany:
+			rel.ForeignKey = util.ToSnakeCase(entity.Name) + "_id"
+			if column := backReference(target, entity.Name); column != "" {
+				rel.ForeignKey = column
+			}
+			rel.KeyType = key.Type()
+
+			if target != nil && target != entity && !hasColumn(target.Fields, rel.ForeignKey) {
+				target.Fields = append(target.Fields, models.Field{
+					Name:    entity.Name + "ID",
+					Type:    key.Type(),
+					JSONTag: rel.ForeignKey,
+					DBTag:   rel.ForeignKey,
+					Index:   true,
+				})
+			}
+
+		case models.RelationManyToMany:
+			rel.JoinTable = entity.TableName + "_" + rel.Table
+			rel.ForeignKey = util.ToSnakeCase(entity.Name) + "_id"
+			rel.References = util.ToSnakeCase(rel.Entity) + "_id"
+			rel.KeyType = key.Type()
+			rel.ReferenceType = targetType
+			if rel.References == rel.ForeignKey {
+				rel.References = util.ToSnakeCase(util.Singularize(rel.Name)) + "_id"
+			}
+
+			if inverse := inverseRelation(target, entity.Name); inverse != nil {
+				rel.Inverse = true
+				rel.JoinTable = inverse.JoinTable
+				rel.ForeignKey, rel.References = inverse.References, inverse.ForeignKey
+			}
+		}
+
+		entity.Relations = append(entity.Relations, rel)
+	}
+
+	entity.Fields = fields
+
+	return nil
+}
+
+func addRelationMethods(repo *models.RepositoryConfig, plan *models.GenerationPlan) {
+	entity := plan.GetEntityByName(repo.Entity)
+	if entity == nil {
+		return
+	}
+
+	for _, rel := range entity.Relations {
+		methods := []models.CustomMethod{
+			relationMethod("Load"+rel.Name, rel, []models.MethodParam{{Name: "entity", Type: "*" + entity.Name}}),
+		}
+
+		switch rel.Kind {
+		case models.RelationBelongsTo:
+			methods = append(methods, models.CustomMethod{Name: "FindAllBy" + rel.KeyField()})
+		case models.RelationManyToMany:
+			ids := []models.MethodParam{
+				{Name: "id", Type: rel.KeyType},
+				{Name: util.ToCamelCase(util.Singularize(rel.Name)) + "IDs", Type: "..." + rel.ReferenceType},
+			}
+			methods = append(methods,
+				relationMethod("Add"+rel.Name, rel, ids),
+				relationMethod("Remove"+rel.Name, rel, ids),
+			)
+		}
+
+		for _, method := range methods {
+			if !hasMethod(repo, method.Name) {
+				repo.CustomMethods = append(repo.CustomMethods, method)
+			}
+		}
+	}
+}
+
+func relationMethod(name string, rel models.Relation, params []models.MethodParam) models.CustomMethod {
+	return models.CustomMethod{
+		Name:     name,
+		Params:   params,
+		Returns:  []string{"error"},
+		Relation: rel.Name,
+	}
+}
+
+func (r *Resolver) orderPlan(plan *models.GenerationPlan) error {
+	graph := NewGraph()
+	graph.BuildFromPlan(plan)
+
+	order, err := graph.TopologicalSort()
+	if err != nil {
+		return err
+	}
+
+	position := make(map[string]int, len(order))
+	for i, name := range order {
+		position[name] = i
+	}
+
+	sort.SliceStable(plan.Entities, func(i, j int) bool {
+		return position[plan.Entities[i].Name] < position[plan.Entities[j].Name]
+	})
+	sort.SliceStable(plan.Repositories, func(i, j int) bool {
+		return position[plan.Repositories[i].Entity] < position[plan.Repositories[j].Entity]
+	})
+
+	return nil
+}
+
+func relationTarget(typeName string) string {
+	typeName = strings.TrimPrefix(typeName, "*")
+	typeName = strings.TrimPrefix(typeName, "[]")
+	return strings.TrimPrefix(typeName, "*")
+}
+
+func inverseRelation(target *models.EntityConfig, entity string) *models.Relation {
+	if target == nil {
+		return nil
+	}
+	for i := range target.Relations {
+		rel := &target.Relations[i]
+		if rel.Kind == models.RelationManyToMany && rel.Entity == entity && !rel.Inverse {
+			return rel
+		}
+	}
+	return nil
+}
+
+func backReference(target *models.EntityConfig, entity string) string {
+	if target == nil {
+		return ""
+	}
+	for _, rel := range target.Relations {
+		if rel.Kind == models.RelationBelongsTo && rel.Entity == entity {
+			return rel.ForeignKey
+		}
+	}
+	for _, field := range target.Fields {
+		if field.Relation == models.RelationBelongsTo && relationTarget(field.Type) == entity {
+			return util.ToSnakeCase(field.Name) + "_id"
+		}
+	}
+	return ""
+}
+
+func hasColumn(fields []models.Field, column string) bool {
+	for _, field := range fields {
+		if field.DBTag == column {
+			return true
+		}
+		if field.Relation == models.RelationBelongsTo && util.ToSnakeCase(field.Name)+"_id" == column {
+			return true
+		}
+	}
+	return false
+}
+
+func hasMethod(repo *models.RepositoryConfig, name string) bool {
+	for _, method := range repo.CustomMethods {
+		if method.Name == name {
+			return true
+		}
+	}
+	return false
+}
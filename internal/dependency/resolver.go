@@ -9,16 +9,50 @@ import (
 
 type Resolver struct {
 	detector *Detector
+	loader   EntityLoader
+	loaded   map[string][]models.Field
 }
 
+type EntityLoader func(name string) ([]models.Field, error)
+
 func NewResolver(detector *Detector) *Resolver {
 	return &Resolver{
 		detector: detector,
 	}
 }
 
+func (r *Resolver) SetEntityLoader(loader EntityLoader) {
+	r.loader = loader
+}
+
 func (r *Resolver) Resolve(plan *models.GenerationPlan) error {
 
+	if err := r.resolveEnums(plan); err != nil {
+		return err
+	}
+
+	if err := r.resolveKeys(plan); err != nil {
+		return err
+	}
+
+	for _, repo := range plan.Repositories {
+		if entity := plan.GetEntityByName(repo.Entity); entity != nil && repo.Versioned {
+			entity.Versioned = true
+		}
+	}
+
+	if err := r.resolveRelations(plan); err != nil {
+		return err
+	}
+
+	if err := r.checkKeys(plan); err != nil {
+		return err
+	}
+
+	if err := r.resolveCRUD(plan); err != nil {
+		return err
+	}
+
 	for i := range plan.UseCases {
 		uc := &plan.UseCases[i]
 
@@ -40,6 +74,50 @@ func (r *Resolver) Resolve(plan *models.GenerationPlan) error {
 		}
 	}
 
+	for i := range plan.Handlers {
+		handler := &plan.Handlers[i]
+
+		handler.Endpoints = r.detector.DetectHandlerEndpoints(handler, plan)
+		if len(handler.Endpoints) == 0 {
+			return fmt.Errorf("no use cases found for handler %s", handler.Name)
+		}
+
+		for _, endpoint := range handler.Endpoints {
+			if err := r.bindPathID(endpoint, handler.Name, plan); err != nil {
+				return fmt.Errorf("handler %s: %w", handler.Name, err)
+			}
+		}
+	}
+
+	return r.orderPlan(plan)
+}
+
+func (r *Resolver) bindPathID(endpoint models.Endpoint, entityName string, plan *models.GenerationPlan) error {
+	if !strings.Contains(endpoint.Path, "{id}") {
+		return nil
+	}
+
+	uc := plan.GetUseCaseByName(endpoint.UseCase)
+	if uc == nil {
+		return nil
+	}
+	for _, field := range uc.InputFields {
+		if field.Name == "ID" {
+			return nil
+		}
+	}
+
+	if name := r.detector.extractEntityFromUseCaseName(uc.Name); name != "" {
+		entityName = name
+	}
+
+	idType, err := r.keyType(entityName, plan)
+	if err != nil {
+		return fmt.Errorf("use case %s: %w", uc.Name, err)
+	}
+
+	uc.InputFields = append([]models.Field{{Name: "ID", Type: idType, JSONTag: "id"}}, uc.InputFields...)
+
 	return nil
 }
 
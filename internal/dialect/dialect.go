@@ -0,0 +1,150 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	Postgres = "postgres"
+	MySQL    = "mysql"
+	SQLite   = "sqlite"
+	MongoDB  = "mongodb"
+)
+
+type Dialect interface {
+	Name() string
+	Placeholder(n int) string
+	NumberedPlaceholders() bool
+	UpsertClause(key string, columns []string) string
+	ColumnType(goType string) string
+	IdentityColumn(column string) string
+	Returning(column string) string
+}
+
+func Get(name string) (Dialect, error) {
+	switch Normalize(name) {
+	case Postgres:
+		return &postgresDialect{}, nil
+	case MySQL:
+		return &mysqlDialect{}, nil
+	case SQLite:
+		return &sqliteDialect{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported SQL dialect: %s", name)
+	}
+}
+
+func Normalize(name string) string {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "postgres", "postgresql", "pg":
+		return Postgres
+	case "mysql", "mariadb":
+		return MySQL
+	case "sqlite", "sqlite3":
+		return SQLite
+	case "mongodb", "mongo":
+		return MongoDB
+	default:
+		return strings.ToLower(strings.TrimSpace(name))
+	}
+}
+
+func IsSQL(name string) bool {
+	switch Normalize(name) {
+	case Postgres, MySQL, SQLite:
+		return true
+	default:
+		return false
+	}
+}
+
+type postgresDialect struct{}
+
+func (d *postgresDialect) Name() string {
+	return Postgres
+}
+
+func (d *postgresDialect) Placeholder(n int) string {
+	return fmt.Sprintf("$%d", n)
+}
+
+func (d *postgresDialect) NumberedPlaceholders() bool {
+	return true
+}
+
+func (d *postgresDialect) UpsertClause(key string, columns []string) string {
+	return fmt.Sprintf("ON CONFLICT (%s) DO UPDATE SET %s", key, excludedAssignments(columns))
+}
+
+func (d *postgresDialect) IdentityColumn(column string) string {
+	return column + " BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY"
+}
+
+func (d *postgresDialect) Returning(column string) string {
+	return "RETURNING " + column
+}
+
+type mysqlDialect struct{}
+
+func (d *mysqlDialect) Name() string {
+	return MySQL
+}
+
+func (d *mysqlDialect) Placeholder(n int) string {
+	return "?"
+}
+
+func (d *mysqlDialect) NumberedPlaceholders() bool {
+	return false
+}
+
+func (d *mysqlDialect) UpsertClause(key string, columns []string) string {
+	assignments := make([]string, len(columns))
+	for i, column := range columns {
+		assignments[i] = fmt.Sprintf("%s = VALUES(%s)", column, column)
+	}
+	return "ON DUPLICATE KEY UPDATE " + strings.Join(assignments, ", ")
+}
+
+func (d *mysqlDialect) IdentityColumn(column string) string {
+	return column + " BIGINT AUTO_INCREMENT PRIMARY KEY"
+}
+
+func (d *mysqlDialect) Returning(column string) string {
+	return ""
+}
+
+type sqliteDialect struct{}
+
+func (d *sqliteDialect) Name() string {
+	return SQLite
+}
+
+func (d *sqliteDialect) Placeholder(n int) string {
+	return "?"
+}
+
+func (d *sqliteDialect) NumberedPlaceholders() bool {
+	return false
+}
+
+func (d *sqliteDialect) UpsertClause(key string, columns []string) string {
+	return fmt.Sprintf("ON CONFLICT(%s) DO UPDATE SET %s", key, excludedAssignments(columns))
+}
+
+func (d *sqliteDialect) IdentityColumn(column string) string {
+	return column + " INTEGER PRIMARY KEY AUTOINCREMENT"
+}
+
+func (d *sqliteDialect) Returning(column string) string {
+	return "RETURNING " + column
+}
+
+func excludedAssignments(columns []string) string {
+	assignments := make([]string, len(columns))
+	for i, column := range columns {
+		assignments[i] = fmt.Sprintf("%s = excluded.%s", column, column)
+	}
+	return strings.Join(assignments, ", ")
+}
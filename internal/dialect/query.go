@@ -0,0 +1,120 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+
+	"gogen/pkg/models"
+)
+
+const (
+	clauseSeparator = "\n\t\t"
+
+	NotDeleted = "deleted_at IS NULL"
+)
+
+type Queries struct {
+	Insert     string
+	Upsert     string
+	GetByID    string
+	Update     string
+	Delete     string
+	HardDelete string
+	Restore    string
+	Select     string
+	List       string
+
+	ReturnsID bool
+	Numbered  bool
+}
+
+func BuildQueries(d Dialect, schema models.Schema) Queries {
+	key := schema.Key
+	table := schema.Table
+	columns := Columns(schema.Columns)
+	updated := Columns(schema.Updated())
+
+	selectAll := fmt.Sprintf("SELECT %s%sFROM %s", strings.Join(columns, ", "), clauseSeparator, table)
+
+	assignments := make([]string, len(updated))
+	for i, column := range updated {
+		assignments[i] = fmt.Sprintf("%s = %s", column, d.Placeholder(i+1))
+	}
+
+	byKey := keyCondition(d, key, 1)
+	alive := ""
+	if schema.SoftDelete {
+		alive = " AND " + NotDeleted
+	}
+
+	current := ""
+	if schema.Versioned {
+		assignments = append(assignments, "version = version + 1")
+		current = fmt.Sprintf(" AND version = %s", d.Placeholder(len(updated)+len(key.Fields)+1))
+	}
+
+	queries := Queries{
+		Insert: insertInto(d, table, Columns(schema.Inserted())),
+		Upsert: insertInto(d, table, columns) + clauseSeparator +
+			d.UpsertClause(strings.Join(key.Columns(), ", "), updated),
+		GetByID: selectAll + clauseSeparator + "WHERE " + byKey + alive,
+		Update: fmt.Sprintf("UPDATE %s%sSET %s%sWHERE %s%s",
+			table, clauseSeparator, strings.Join(assignments, ", "), clauseSeparator,
+			keyCondition(d, key, len(updated)+1), current+alive),
+		Delete:   fmt.Sprintf("DELETE FROM %s WHERE %s", table, byKey),
+		Select:   fmt.Sprintf("SELECT %s FROM %s", strings.Join(columns, ", "), table),
+		Numbered: d.NumberedPlaceholders(),
+	}
+
+	order := schema.KeysetColumns(schema.DefaultSort().DBTag)
+	for i := range order {
+		order[i] += " DESC"
+	}
+	queries.List = queries.Select
+
+	if schema.SoftDelete {
+		queries.HardDelete = queries.Delete
+		queries.Delete = fmt.Sprintf("UPDATE %s SET deleted_at = CURRENT_TIMESTAMP WHERE %s%s", table, byKey, alive)
+		queries.Restore = fmt.Sprintf("UPDATE %s SET deleted_at = NULL WHERE %s AND deleted_at IS NOT NULL", table, byKey)
+		queries.List += " WHERE " + NotDeleted
+	}
+	queries.List += " ORDER BY " + strings.Join(order, ", ") + " LIMIT " + d.Placeholder(1)
+
+	if key.AutoIncrement() {
+		if returning := d.Returning("id"); returning != "" {
+			queries.Insert += clauseSeparator + returning
+			queries.ReturnsID = true
+		}
+	}
+
+	return queries
+}
+
+func insertInto(d Dialect, table string, columns []string) string {
+	return fmt.Sprintf("INSERT INTO %s (%s)%sVALUES (%s)",
+		table, strings.Join(columns, ", "), clauseSeparator, placeholders(d, 1, len(columns)))
+}
+
+func keyCondition(d Dialect, key models.PrimaryKey, start int) string {
+	conditions := make([]string, len(key.Fields))
+	for i, column := range key.Columns() {
+		conditions[i] = fmt.Sprintf("%s = %s", column, d.Placeholder(start+i))
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+func Columns(fields []models.Field) []string {
+	columns := make([]string, 0, len(fields))
+	for _, field := range fields {
+		columns = append(columns, field.DBTag)
+	}
+	return columns
+}
+
+func placeholders(d Dialect, start, count int) string {
+	result := make([]string, count)
+	for i := range result {
+		result[i] = d.Placeholder(start + i)
+	}
+	return strings.Join(result, ", ")
+}
@@ -0,0 +1,53 @@
+package dialect
+
+import (
+	"fmt"
+	"strings"
+
+	"gogen/pkg/models"
+)
+
+type RelationQueries struct {
+	Select string
+	Insert string
+	Delete string
+}
+
+func BuildRelationQueries(d Dialect, rel models.Relation) RelationQueries {
+	columns := Columns(rel.TargetFields)
+
+	alive := ""
+	if rel.TargetSoftDelete() {
+		alive = " AND " + NotDeleted
+	}
+
+	switch rel.Kind {
+	case models.RelationBelongsTo:
+		return RelationQueries{Select: selectWhere(d, rel.Table, columns, "id") + alive}
+	case models.RelationHasMany:
+		return RelationQueries{Select: selectWhere(d, rel.Table, columns, rel.ForeignKey) + alive}
+	}
+
+	qualified := make([]string, len(columns))
+	for i, column := range columns {
+		qualified[i] = "t." + column
+	}
+	if alive != "" {
+		alive = " AND t." + NotDeleted
+	}
+
+	return RelationQueries{
+		Select: fmt.Sprintf("SELECT %s%sFROM %s t%sJOIN %s j ON j.%s = t.id%sWHERE j.%s = %s%s",
+			strings.Join(qualified, ", "), clauseSeparator, rel.Table, clauseSeparator,
+			rel.JoinTable, rel.References, clauseSeparator, rel.ForeignKey, d.Placeholder(1), alive),
+		Insert: fmt.Sprintf("INSERT INTO %s (%s, %s) VALUES (%s)",
+			rel.JoinTable, rel.ForeignKey, rel.References, placeholders(d, 1, 2)),
+		Delete: fmt.Sprintf("DELETE FROM %s WHERE %s = %s AND %s = %s",
+			rel.JoinTable, rel.ForeignKey, d.Placeholder(1), rel.References, d.Placeholder(2)),
+	}
+}
+
+func selectWhere(d Dialect, table string, columns []string, column string) string {
+	return fmt.Sprintf("SELECT %s%sFROM %s%sWHERE %s = %s",
+		strings.Join(columns, ", "), clauseSeparator, table, clauseSeparator, column, d.Placeholder(1))
+}
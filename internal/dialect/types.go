@@ -0,0 +1,101 @@
+package dialect
+
+import "strings"
+
+func (d *postgresDialect) ColumnType(goType string) string {
+	switch goType {
+	case "string":
+		return "TEXT"
+	case "int", "int64", "uint32", "uint", "uint64":
+		return "BIGINT"
+	case "int32", "uint16":
+		return "INTEGER"
+	case "int8", "int16", "uint8", "byte":
+		return "SMALLINT"
+	case "bool":
+		return "BOOLEAN"
+	case "float32":
+		return "REAL"
+	case "float64":
+		return "DOUBLE PRECISION"
+	case "time.Time":
+		return "TIMESTAMPTZ"
+	case "uuid.UUID":
+		return "UUID"
+	case "[]byte":
+		return "BYTEA"
+	case "[]string":
+		return "TEXT[]"
+	case "json.RawMessage":
+		return "JSONB"
+	}
+
+	if isCollection(goType) {
+		return "JSONB"
+	}
+	return "TEXT"
+}
+
+func (d *mysqlDialect) ColumnType(goType string) string {
+	switch goType {
+	case "string":
+		return "VARCHAR(255)"
+	case "int", "int64":
+		return "BIGINT"
+	case "int32":
+		return "INT"
+	case "int16":
+		return "SMALLINT"
+	case "int8":
+		return "TINYINT"
+	case "uint", "uint64":
+		return "BIGINT UNSIGNED"
+	case "uint32":
+		return "INT UNSIGNED"
+	case "uint16":
+		return "SMALLINT UNSIGNED"
+	case "uint8", "byte":
+		return "TINYINT UNSIGNED"
+	case "bool":
+		return "BOOLEAN"
+	case "float32":
+		return "FLOAT"
+	case "float64":
+		return "DOUBLE"
+	case "time.Time":
+		return "DATETIME(6)"
+	case "uuid.UUID":
+		return "CHAR(36)"
+	case "[]byte":
+		return "BLOB"
+	case "json.RawMessage":
+		return "JSON"
+	}
+
+	if isCollection(goType) {
+		return "JSON"
+	}
+	return "TEXT"
+}
+
+func (d *sqliteDialect) ColumnType(goType string) string {
+	switch goType {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "byte":
+		return "INTEGER"
+	case "bool":
+		return "BOOLEAN"
+	case "float32", "float64":
+		return "REAL"
+	case "time.Time":
+		return "TIMESTAMP"
+	case "[]byte":
+		return "BLOB"
+	default:
+		return "TEXT"
+	}
+}
+
+func isCollection(goType string) bool {
+	return strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[")
+}
@@ -0,0 +1,163 @@
+package file
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	diffContext = 3
+	DevNull     = "/dev/null"
+)
+
+type diffLine struct {
+	kind byte
+	text string
+}
+
+func UnifiedDiff(path, before, after string) string {
+	return Diff("a/"+path, "b/"+path, before, after)
+}
+
+// Diff renders a unified diff between two named sides; pass DevNull as the name of a
+// side that does not exist.
+func Diff(from, to, before, after string) string {
+	if before == after && from != DevNull && to != DevNull {
+		return ""
+	}
+
+	lines := diffLines(splitLines(before), splitLines(after))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n", from)
+	fmt.Fprintf(&b, "+++ %s\n", to)
+
+	for _, hunk := range diffHunks(lines) {
+		writeHunk(&b, lines, hunk[0], hunk[1])
+	}
+
+	return b.String()
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+func diffLines(a, b []string) []diffLine {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var result []diffLine
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			result = append(result, diffLine{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			result = append(result, diffLine{'-', a[i]})
+			i++
+		default:
+			result = append(result, diffLine{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		result = append(result, diffLine{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		result = append(result, diffLine{'+', b[j]})
+	}
+
+	return result
+}
+
+func diffHunks(lines []diffLine) [][2]int {
+	var hunks [][2]int
+
+	for i := 0; i < len(lines); i++ {
+		if lines[i].kind == ' ' {
+			continue
+		}
+
+		start := max(i-diffContext, 0)
+		end := i
+		for end < len(lines) {
+			if lines[end].kind != ' ' {
+				end++
+				continue
+			}
+
+			next := end
+			for next < len(lines) && lines[next].kind == ' ' {
+				next++
+			}
+			if next == len(lines) || next-end > 2*diffContext {
+				break
+			}
+			end = next
+		}
+		end = min(end+diffContext, len(lines))
+
+		if n := len(hunks); n > 0 && hunks[n-1][1] >= start {
+			hunks[n-1][1] = end
+		} else {
+			hunks = append(hunks, [2]int{start, end})
+		}
+		i = end - 1
+	}
+
+	return hunks
+}
+
+func writeHunk(b *strings.Builder, lines []diffLine, start, end int) {
+	oldStart, newStart := 1, 1
+	for _, line := range lines[:start] {
+		if line.kind != '+' {
+			oldStart++
+		}
+		if line.kind != '-' {
+			newStart++
+		}
+	}
+
+	var oldCount, newCount int
+	for _, line := range lines[start:end] {
+		if line.kind != '+' {
+			oldCount++
+		}
+		if line.kind != '-' {
+			newCount++
+		}
+	}
+
+	if oldCount == 0 {
+		oldStart--
+	}
+	if newCount == 0 {
+		newStart--
+	}
+
+	fmt.Fprintf(b, "@@ -%d,%d +%d,%d @@\n", oldStart, oldCount, newStart, newCount)
+	for _, line := range lines[start:end] {
+		b.WriteByte(line.kind)
+		b.WriteString(line.text)
+		b.WriteByte('\n')
+	}
+}
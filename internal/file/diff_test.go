@@ -0,0 +1,57 @@
+package file
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		from   string
+		to     string
+		before string
+		after  string
+		want   []string
+	}{
+		{
+			name:   "changed file",
+			from:   "a/user.go",
+			to:     "b/user.go",
+			before: "package domain\n\ntype User struct{}\n",
+			after:  "package domain\n\ntype Account struct{}\n",
+			want:   []string{"--- a/user.go\n", "+++ b/user.go\n", "-type User struct{}\n", "+type Account struct{}\n"},
+		},
+		{
+			name:  "missing file",
+			from:  DevNull,
+			to:    "b/user.go",
+			after: "package domain\n",
+			want:  []string{"--- /dev/null\n", "+++ b/user.go\n", "+package domain\n"},
+		},
+		{
+			name:   "removed file",
+			from:   "a/user.go",
+			to:     DevNull,
+			before: "package domain\n",
+			want:   []string{"--- a/user.go\n", "+++ /dev/null\n", "-package domain\n"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Diff(tt.from, tt.to, tt.before, tt.after)
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("Diff() = %q, want it to contain %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestDiffUnchanged(t *testing.T) {
+	if got := UnifiedDiff("user.go", "package domain\n", "package domain\n"); got != "" {
+		t.Errorf("UnifiedDiff() = %q, want empty diff", got)
+	}
+}
@@ -0,0 +1,278 @@
+package file
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+const (
+	RegionBegin = "// gogen:begin "
+	RegionEnd   = "// gogen:end "
+)
+
+// Merge carries user code from existing into generated. previous holds the region hashes
+// recorded when the file was last generated; a region whose body still matches its hash
+// was never edited and takes the newly generated body.
+func Merge(existing, generated string, previous map[string]string) (string, error) {
+	fset := token.NewFileSet()
+
+	oldFile, err := parser.ParseFile(fset, "", existing, parser.ParseComments)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse existing file: %w", err)
+	}
+
+	generated = mergeRegions(existing, generated, functionBodies(fset, oldFile, existing), previous)
+
+	newFile, err := parser.ParseFile(token.NewFileSet(), "", generated, parser.ParseComments)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse generated file: %w", err)
+	}
+
+	declared := make(map[string]bool)
+	for _, decl := range newFile.Decls {
+		for _, name := range declNames(decl) {
+			declared[name] = true
+		}
+	}
+
+	var preserved []string
+	for _, decl := range oldFile.Decls {
+		names := declNames(decl)
+		if len(names) == 0 || anyDeclared(names, declared) {
+			continue
+		}
+		preserved = append(preserved, sourceOf(fset, existing, decl))
+	}
+
+	if len(preserved) == 0 {
+		return generated, nil
+	}
+
+	merged := strings.TrimRight(generated, "\n") + "\n\n" + strings.Join(preserved, "\n\n") + "\n"
+
+	return addImports(merged, oldFile.Imports)
+}
+
+func mergeRegions(existing, generated string, bodies, previous map[string]string) string {
+	regions := extractRegions(existing)
+
+	lines := strings.Split(generated, "\n")
+	result := make([]string, 0, len(lines))
+
+	for i := 0; i < len(lines); i++ {
+		result = append(result, lines[i])
+
+		name, ok := regionName(lines[i], RegionBegin)
+		if !ok {
+			continue
+		}
+
+		content, found := regions[name]
+		if !found {
+			content, found = bodies[name]
+		}
+		if !found || previous[name] == hashRegion(content) {
+			continue
+		}
+
+		end := i + 1
+		for end < len(lines) {
+			if endName, ok := regionName(lines[end], RegionEnd); ok && endName == name {
+				break
+			}
+			end++
+		}
+		if end == len(lines) {
+			continue
+		}
+
+		if content != "" {
+			result = append(result, content)
+		}
+		i = end - 1
+	}
+
+	return strings.Join(result, "\n")
+}
+
+func extractRegions(source string) map[string]string {
+	regions := make(map[string]string)
+
+	lines := strings.Split(source, "\n")
+	for i := 0; i < len(lines); i++ {
+		name, ok := regionName(lines[i], RegionBegin)
+		if !ok {
+			continue
+		}
+
+		for j := i + 1; j < len(lines); j++ {
+			if endName, ok := regionName(lines[j], RegionEnd); ok && endName == name {
+				regions[name] = strings.Join(lines[i+1:j], "\n")
+				i = j
+				break
+			}
+		}
+	}
+
+	return regions
+}
+
+func RegionHashes(source string) map[string]string {
+	regions := extractRegions(source)
+	if len(regions) == 0 {
+		return nil
+	}
+
+	hashes := make(map[string]string, len(regions))
+	for name, content := range regions {
+		hashes[name] = hashRegion(content)
+	}
+
+	return hashes
+}
+
+func hashRegion(content string) string {
+	normalized := strings.TrimSpace(strings.ReplaceAll(content, "\r", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func regionName(line, marker string) (string, bool) {
+	trimmed := strings.TrimSpace(line)
+	if !strings.HasPrefix(trimmed, marker) {
+		return "", false
+	}
+	return strings.TrimSpace(strings.TrimPrefix(trimmed, marker)), true
+}
+
+func functionBodies(fset *token.FileSet, file *ast.File, source string) map[string]string {
+	bodies := make(map[string]string)
+
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		start := fset.Position(fn.Body.Lbrace).Offset + 1
+		end := fset.Position(fn.Body.Rbrace).Offset
+		bodies[funcName(fn)] = strings.Trim(source[start:end], "\n")
+	}
+
+	return bodies
+}
+
+func declNames(decl ast.Decl) []string {
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		return []string{funcName(d)}
+	case *ast.GenDecl:
+		if d.Tok == token.IMPORT {
+			return nil
+		}
+
+		var names []string
+		for _, spec := range d.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				names = append(names, s.Name.Name)
+			case *ast.ValueSpec:
+				for _, name := range s.Names {
+					if name.Name != "_" {
+						names = append(names, name.Name)
+					}
+				}
+			}
+		}
+		return names
+	default:
+		return nil
+	}
+}
+
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	if index, ok := recv.(*ast.IndexExpr); ok {
+		recv = index.X
+	}
+	if index, ok := recv.(*ast.IndexListExpr); ok {
+		recv = index.X
+	}
+	if ident, ok := recv.(*ast.Ident); ok {
+		return ident.Name + "." + fn.Name.Name
+	}
+
+	return fn.Name.Name
+}
+
+func anyDeclared(names []string, declared map[string]bool) bool {
+	for _, name := range names {
+		if declared[name] {
+			return true
+		}
+	}
+	return false
+}
+
+func sourceOf(fset *token.FileSet, source string, decl ast.Decl) string {
+	start := decl.Pos()
+
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Doc != nil {
+			start = d.Doc.Pos()
+		}
+	case *ast.GenDecl:
+		if d.Doc != nil {
+			start = d.Doc.Pos()
+		}
+	}
+
+	return source[fset.Position(start).Offset:fset.Position(decl.End()).Offset]
+}
+
+func addImports(source string, specs []*ast.ImportSpec) (string, error) {
+	fset := token.NewFileSet()
+
+	file, err := parser.ParseFile(fset, "", source, parser.ParseComments)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse merged file: %w", err)
+	}
+
+	for _, spec := range specs {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		name := ""
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		astutil.AddNamedImport(fset, file, name, path)
+	}
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return "", fmt.Errorf("failed to format merged file: %w", err)
+	}
+
+	return buf.String(), nil
+}
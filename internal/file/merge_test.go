@@ -0,0 +1,92 @@
+package file
+
+import (
+	"strings"
+	"testing"
+)
+
+const generatedV1 = `package usecase
+
+type CreateProductUseCase struct{}
+
+func (uc *CreateProductUseCase) Execute() string {
+	// gogen:begin CreateProductUseCase.Execute
+	return NewProduct("name")
+	// gogen:end CreateProductUseCase.Execute
+}
+`
+
+const generatedV2 = `package usecase
+
+type CreateProductUseCase struct{}
+
+func (uc *CreateProductUseCase) Execute() string {
+	// gogen:begin CreateProductUseCase.Execute
+	return NewProduct("name", 10)
+	// gogen:end CreateProductUseCase.Execute
+}
+`
+
+func TestMergeRegions(t *testing.T) {
+	edited := strings.Replace(generatedV1, `return NewProduct("name")`, `return "custom"`, 1)
+
+	tests := []struct {
+		name     string
+		existing string
+		previous map[string]string
+		want     string
+		dontWant string
+	}{
+		{
+			name:     "unedited region takes the new body",
+			existing: generatedV1,
+			previous: RegionHashes(generatedV1),
+			want:     `return NewProduct("name", 10)`,
+			dontWant: `return NewProduct("name")`,
+		},
+		{
+			name:     "edited region keeps the user body",
+			existing: edited,
+			previous: RegionHashes(generatedV1),
+			want:     `return "custom"`,
+			dontWant: `NewProduct`,
+		},
+		{
+			name:     "region without recorded hash is kept",
+			existing: generatedV1,
+			want:     `return NewProduct("name")`,
+			dontWant: `NewProduct("name", 10)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Merge(tt.existing, generatedV2, tt.previous)
+			if err != nil {
+				t.Fatalf("Merge() error = %v", err)
+			}
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("Merge() = %q, want it to contain %q", got, tt.want)
+			}
+			if strings.Contains(got, tt.dontWant) {
+				t.Errorf("Merge() = %q, must not contain %q", got, tt.dontWant)
+			}
+		})
+	}
+}
+
+func TestMergeKeepsHandWrittenDeclarations(t *testing.T) {
+	existing := generatedV1 + `
+func helper() string {
+	return "helper"
+}
+`
+
+	got, err := Merge(existing, generatedV2, RegionHashes(generatedV1))
+	if err != nil {
+		t.Fatalf("Merge() error = %v", err)
+	}
+	if !strings.Contains(got, "func helper() string") {
+		t.Errorf("Merge() dropped the hand-written helper:\n%s", got)
+	}
+}
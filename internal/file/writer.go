@@ -10,6 +10,9 @@ import (
 type Writer struct {
 	projectRoot string
 	written     []string
+	originals   map[string][]byte
+	allowed     map[string]bool
+	memory      map[string]string
 	mu          sync.Mutex
 }
 
@@ -17,17 +20,64 @@ func NewWriter(projectRoot string) *Writer {
 	return &Writer{
 		projectRoot: projectRoot,
 		written:     make([]string, 0),
+		originals:   make(map[string][]byte),
+		allowed:     make(map[string]bool),
 	}
 }
 
+func NewMemoryWriter(projectRoot string) *Writer {
+	w := NewWriter(projectRoot)
+	w.memory = make(map[string]string)
+	return w
+}
+
+func (w *Writer) AllowOverwrite(paths ...string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for _, path := range paths {
+		w.allowed[w.fullPath(path)] = true
+	}
+}
+
+func (w *Writer) CanOverwrite(relativePath string) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return w.memory != nil || w.allowed[w.fullPath(relativePath)]
+}
+
+func (w *Writer) fullPath(path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+	return filepath.Join(w.projectRoot, path)
+}
+
 func (w *Writer) Write(relativePath, content string, overwrite bool) error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
-	fullPath := filepath.Join(w.projectRoot, relativePath)
+	fullPath := w.fullPath(relativePath)
+
+	if w.memory != nil {
+		w.memory[fullPath] = content
+		w.written = append(w.written, fullPath)
+		return nil
+	}
+
+	if _, err := os.Stat(fullPath); err == nil {
+		if !overwrite && !w.allowed[fullPath] {
+			return fmt.Errorf("file already exists: %s", relativePath)
+		}
 
-	if _, err := os.Stat(fullPath); err == nil && !overwrite {
-		return fmt.Errorf("file already exists: %s", relativePath)
+		if _, saved := w.originals[fullPath]; !saved {
+			original, err := os.ReadFile(fullPath)
+			if err != nil {
+				return fmt.Errorf("failed to read existing file: %w", err)
+			}
+			w.originals[fullPath] = original
+		}
 	}
 
 	dir := filepath.Dir(fullPath)
@@ -44,6 +94,50 @@ func (w *Writer) Write(relativePath, content string, overwrite bool) error {
 	return nil
 }
 
+func (w *Writer) Exists(relativePath string) bool {
+	if _, ok := w.buffered(relativePath); ok {
+		return true
+	}
+
+	_, err := os.Stat(filepath.Join(w.projectRoot, relativePath))
+	return err == nil
+}
+
+func (w *Writer) Read(relativePath string) (string, error) {
+	if content, ok := w.buffered(relativePath); ok {
+		return content, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(w.projectRoot, relativePath))
+	if err != nil {
+		return "", fmt.Errorf("failed to read file: %w", err)
+	}
+	return string(data), nil
+}
+
+func (w *Writer) buffered(relativePath string) (string, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	content, ok := w.memory[w.fullPath(relativePath)]
+	return content, ok
+}
+
+func (w *Writer) Contents() map[string]string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	result := make(map[string]string, len(w.memory))
+	for path, content := range w.memory {
+		result[path] = content
+	}
+	return result
+}
+
+func (w *Writer) Glob(pattern string) ([]string, error) {
+	return filepath.Glob(filepath.Join(w.projectRoot, pattern))
+}
+
 func (w *Writer) WriteIfNotExists(relativePath, content string) error {
 	return w.Write(relativePath, content, false)
 }
@@ -52,17 +146,31 @@ func (w *Writer) Rollback() error {
 	w.mu.Lock()
 	defer w.mu.Unlock()
 
+	if w.memory != nil {
+		w.written = make([]string, 0)
+		w.memory = make(map[string]string)
+		return nil
+	}
+
 	var errors []error
 
 	for i := len(w.written) - 1; i >= 0; i-- {
 		path := w.written[i]
 
+		if original, ok := w.originals[path]; ok {
+			if err := os.WriteFile(path, original, 0644); err != nil {
+				errors = append(errors, fmt.Errorf("failed to restore %s: %w", path, err))
+			}
+			continue
+		}
+
 		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
 			errors = append(errors, fmt.Errorf("failed to remove %s: %w", path, err))
 		}
 	}
 
 	w.written = make([]string, 0)
+	w.originals = make(map[string][]byte)
 
 	if len(errors) > 0 {
 		return fmt.Errorf("rollback completed with errors: %v", errors)
@@ -85,4 +193,5 @@ func (w *Writer) Clear() {
 	defer w.mu.Unlock()
 
 	w.written = make([]string, 0)
+	w.originals = make(map[string][]byte)
 }
@@ -0,0 +1,57 @@
+package generator
+
+import (
+	"strings"
+
+	"gogen/internal/template"
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+func (g *Generator) crudData(uc *models.UseCaseConfig, plan *models.GenerationPlan) *template.CRUDData {
+	if uc.CRUD == "" {
+		return nil
+	}
+
+	entity := plan.GetEntityByName(strings.TrimPrefix(uc.Name, uc.CRUD))
+	if entity == nil {
+		return nil
+	}
+
+	data := &template.CRUDData{
+		Action:         uc.CRUD,
+		Entity:         entity.Name,
+		Fields:         entity.Fields,
+		IDStrategy:     string(entity.IDStrategy),
+		Versioned:      entity.Versioned,
+		Timestamps:     !entity.NoTimestamps,
+		Samples:        fieldSamples(entity.Fields, "domain"),
+		InMemoryImport: util.JoinModulePath(plan.ModulePath, g.config.Paths.InMemory),
+	}
+
+	key := entity.PrimaryKey()
+	if len(key.Fields) == 1 {
+		data.MissingID = keySample(key.Fields[0], entity.Fields, "domain")
+	}
+
+	switch {
+	case key.AutoIncrement():
+	case key.Type() == "uuid.UUID":
+		data.SeedID = "uuid.New()"
+	case key.Type() == "string":
+		data.SeedID = `"seed"`
+	default:
+		data.SeedID = key.Type() + "(1)"
+	}
+
+	return data
+}
+
+func crudFields(fields []models.Field) []models.Field {
+	result := make([]models.Field, len(fields))
+	for i, field := range fields {
+		field.Type = domainType(field.Type, "domain")
+		result[i] = field
+	}
+	return result
+}
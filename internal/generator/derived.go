@@ -0,0 +1,116 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"gogen/internal/dialect"
+	"gogen/internal/query"
+	"gogen/internal/template"
+	"gogen/pkg/models"
+)
+
+func (g *Generator) resolveDerivedMethods(plan *models.GenerationPlan) error {
+	for i := range plan.Repositories {
+		repo := &plan.Repositories[i]
+		schema := repositorySchema(repo, plan)
+
+		for j := range repo.CustomMethods {
+			cm := &repo.CustomMethods[j]
+			if _, err := deriveMethod(cm, repo.Entity, schema); err != nil {
+				return fmt.Errorf("repository %s: method %s: %w", repo.Name, cm.Name, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func repositoryFields(repo *models.RepositoryConfig, plan *models.GenerationPlan) []models.Field {
+	if len(repo.Fields) > 0 {
+		return repo.Fields
+	}
+	if entity := plan.GetEntityByName(repo.Entity); entity != nil {
+		return entity.Fields
+	}
+	return nil
+}
+
+func repositorySchema(repo *models.RepositoryConfig, plan *models.GenerationPlan) models.Schema {
+	entity := plan.GetEntityByName(repo.Entity)
+	if entity == nil {
+		entity = &models.EntityConfig{Name: repo.Entity, Versioned: repo.Versioned}
+	}
+
+	schema := entity.Schema()
+	schema.Table = repo.TableName
+	schema.Columns = append(entity.ImplicitFields(), repositoryFields(repo, plan)...)
+
+	return schema
+}
+
+func deriveMethod(cm *models.CustomMethod, entity string, schema models.Schema) (*query.Method, error) {
+	if cm.Body != "" || cm.Relation != "" || !query.IsDerived(cm.Name) {
+		return nil, nil
+	}
+
+	method, err := query.Parse(cm.Name, schema.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cm.Params) == 0 {
+		cm.Params = method.Params()
+	} else {
+		if len(cm.Params) != method.Arity() {
+			return nil, fmt.Errorf("expects %d params derived from its name, got %d", method.Arity(), len(cm.Params))
+		}
+		names := make([]string, len(cm.Params))
+		for i, p := range cm.Params {
+			names[i] = p.Name
+		}
+		method.Rename(names)
+	}
+
+	if len(cm.Returns) == 0 || (len(cm.Returns) == 1 && cm.Returns[0] == "error") {
+		cm.Returns = method.Returns(entity)
+		cm.ResultNames = nil
+		return method, nil
+	}
+
+	if len(cm.Returns) != 2 || cm.Returns[1] != "error" {
+		return nil, fmt.Errorf("derived query must return %s", resultList(method.Returns(entity), nil))
+	}
+	if method.Kind == query.KindFind {
+		method.Many = strings.HasPrefix(cm.Returns[0], "[]")
+	}
+
+	return method, nil
+}
+
+func derivedQuery(cm models.CustomMethod, repo *models.RepositoryConfig, schema models.Schema, dbType string) (*template.DerivedQuery, error) {
+	method, err := deriveMethod(&cm, repo.Entity, schema)
+	if err != nil || method == nil {
+		return nil, err
+	}
+
+	q := &template.DerivedQuery{
+		Kind: string(method.Kind),
+		Many: method.Many,
+		Args: method.Args(),
+	}
+
+	if dbType == dialect.MongoDB {
+		q.Filter = method.MongoFilter(schema.SoftDelete)
+		q.Sort = method.MongoSort()
+		return q, nil
+	}
+
+	d, err := dialect.Get(dbType)
+	if err != nil {
+		return nil, err
+	}
+	q.SQL = method.SQL(d, schema)
+
+	return q, nil
+}
@@ -5,12 +5,18 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"gogen/internal/dialect"
 	"gogen/internal/template"
 	"gogen/internal/util"
 	"gogen/pkg/models"
 )
 
 func (g *Generator) GenerateEntity(ctx context.Context, entity *models.EntityConfig, plan *models.GenerationPlan) error {
+	style, err := g.validationStyle()
+	if err != nil {
+		return err
+	}
+	checks, patterns := validationChecks(entity.Name, entity.Fields, style)
 
 	data := template.EntityData{
 		Name:          entity.Name,
@@ -20,6 +26,16 @@ func (g *Generator) GenerateEntity(ctx context.Context, entity *models.EntityCon
 		AddComments:   entity.AddComments || g.config.Generation.AddComments,
 		AddValidation: entity.AddValidation,
 		JSONStyle:     entity.JSONStyle,
+		BSON:          g.storedInMongo(entity.Name, plan),
+		Relations:     entity.Relations,
+		Enums:         entityEnums(entity.Fields),
+		Checks:        checks,
+		Patterns:      patterns,
+		ValidateTags:  style != models.ValidationMethods,
+		ValidateAll:   style == models.ValidationTags,
+		Key:           keyData(entity.PrimaryKey(), entity.Fields, ""),
+		Tracking:      trackingFields(entity),
+		Timestamps:    !entity.NoTimestamps,
 	}
 
 	if data.TableName == "" {
@@ -45,9 +61,35 @@ func (g *Generator) GenerateEntity(ctx context.Context, entity *models.EntityCon
 	fileName := util.ToSnakeCase(entity.Name) + ".go"
 	filePath := filepath.Join(g.config.Paths.Domain, fileName)
 
-	if err := g.writer.Write(filePath, withImports, false); err != nil {
+	snapshot := *entity
+	snapshot.Existing = false
+	snapshot.PreviousFields = nil
+	snapshot.Previous = nil
+
+	if err := g.write(output{
+		path:      filePath,
+		content:   withImports,
+		component: models.ComponentTypeEntity,
+		name:      entity.Name,
+		template:  "entity",
+		entity:    &snapshot,
+	}, entity.Existing); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (g *Generator) entityExists(entityName string, plan *models.GenerationPlan) bool {
+	entity := plan.GetEntityByName(entityName)
+	return entity != nil && entity.Existing
+}
+
+func (g *Generator) storedInMongo(entityName string, plan *models.GenerationPlan) bool {
+	for _, repo := range plan.Repositories {
+		if repo.Entity == entityName && dialect.Normalize(repo.DBType) == dialect.MongoDB {
+			return true
+		}
+	}
+	return false
+}
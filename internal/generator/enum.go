@@ -0,0 +1,41 @@
+package generator
+
+import (
+	"gogen/internal/template"
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+func entityEnums(fields []models.Field) []template.EnumData {
+	var enums []template.EnumData
+
+	for _, field := range fields {
+		if !field.IsEnum() {
+			continue
+		}
+
+		enum := template.EnumData{Name: field.BaseType(), Optional: !field.Required && !field.IsPointer()}
+		for _, value := range field.Enum {
+			enum.Values = append(enum.Values, template.EnumValue{
+				Name:  enumConstant(enum.Name, value),
+				Value: value,
+			})
+		}
+		enums = append(enums, enum)
+	}
+
+	return enums
+}
+
+func enumConstant(typeName, value string) string {
+	return typeName + util.ToPascalIdentifier(value)
+}
+
+func enumSample(fields []models.Field, typeName, pkg string) string {
+	for _, field := range fields {
+		if field.IsEnum() && !field.IsPointer() && field.Type == typeName {
+			return domainType(enumConstant(field.BaseType(), field.Enum[0]), pkg)
+		}
+	}
+	return ""
+}
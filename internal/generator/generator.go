@@ -3,9 +3,11 @@ package generator
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"gogen/internal/file"
 	"gogen/internal/format"
+	"gogen/internal/manifest"
 	"gogen/internal/template"
 	"gogen/pkg/models"
 )
@@ -16,6 +18,9 @@ type Generator struct {
 	formatter *format.Formatter
 	imports   *format.ImportsManager
 	config    *models.Config
+	manifest  *manifest.Manifest
+
+	migrationClock time.Time
 }
 
 func NewGenerator(
@@ -31,12 +36,69 @@ func NewGenerator(
 		formatter: formatter,
 		imports:   imports,
 		config:    config,
+
+		migrationClock: time.Now(),
 	}
 }
 
+func (g *Generator) UseManifest(m *manifest.Manifest) {
+	g.manifest = m
+}
+
 func (g *Generator) Generate(ctx context.Context, plan *models.GenerationPlan) error {
 
+	if err := g.resolveDerivedMethods(plan); err != nil {
+		return err
+	}
+
+	if err := g.checkErrorHandling(); err != nil {
+		return err
+	}
+
+	if err := g.checkObservability(); err != nil {
+		return err
+	}
+
+	if len(plan.Entities) > 0 {
+		if err := g.generateValidation(ctx, plan); err != nil {
+			return fmt.Errorf("failed to generate validation helpers: %w", err)
+		}
+	}
+
+	if plan.HasAudit() {
+		if err := g.generateAudit(ctx, plan); err != nil {
+			return fmt.Errorf("failed to generate audit helpers: %w", err)
+		}
+	}
+
+	if len(plan.Entities) > 0 || len(plan.Repositories) > 0 || len(plan.Handlers) > 0 {
+		if err := g.generateErrors(ctx, plan); err != nil {
+			return fmt.Errorf("failed to generate domain errors: %w", err)
+		}
+	}
+
+	if len(plan.Repositories) > 0 {
+		if err := g.generateRepositoryErrors(ctx, plan); err != nil {
+			return fmt.Errorf("failed to generate repository errors: %w", err)
+		}
+		if err := g.generatePagination(ctx, plan); err != nil {
+			return fmt.Errorf("failed to generate pagination helpers: %w", err)
+		}
+		if err := g.generateInMemorySupport(ctx, plan); err != nil {
+			return fmt.Errorf("failed to generate in-memory helpers: %w", err)
+		}
+	}
+
+	if plan.HasTransactions() {
+		if err := g.generateTransactions(ctx, plan); err != nil {
+			return fmt.Errorf("failed to generate transaction helpers: %w", err)
+		}
+	}
+
 	for _, entity := range plan.Entities {
+		if entity.Reused {
+			continue
+		}
 		if err := g.GenerateEntity(ctx, &entity, plan); err != nil {
 			return fmt.Errorf("failed to generate entity %s: %w", entity.Name, err)
 		}
@@ -48,12 +110,40 @@ func (g *Generator) Generate(ctx context.Context, plan *models.GenerationPlan) e
 		}
 	}
 
+	for _, repo := range plan.Repositories {
+		if err := g.GenerateMigration(ctx, &repo, plan); err != nil {
+			return fmt.Errorf("failed to generate migration for %s: %w", repo.Name, err)
+		}
+	}
+
+	if err := g.generateObservability(ctx, plan); err != nil {
+		return fmt.Errorf("failed to generate use case decorators: %w", err)
+	}
+
+	if plan.HasCRUD() {
+		if err := g.generateUseCaseErrors(ctx, plan); err != nil {
+			return fmt.Errorf("failed to generate use case errors: %w", err)
+		}
+	}
+
 	for _, uc := range plan.UseCases {
 		if err := g.GenerateUseCase(ctx, &uc, plan); err != nil {
 			return fmt.Errorf("failed to generate usecase %s: %w", uc.Name, err)
 		}
 	}
 
+	if len(plan.Handlers) > 0 {
+		if err := g.generateHandlerResponse(ctx, plan); err != nil {
+			return fmt.Errorf("failed to generate handler helpers: %w", err)
+		}
+	}
+
+	for _, handler := range plan.Handlers {
+		if err := g.GenerateHandler(ctx, &handler, plan); err != nil {
+			return fmt.Errorf("failed to generate handler %s: %w", handler.Name, err)
+		}
+	}
+
 	if plan.WithMocks {
 		for _, repo := range plan.Repositories {
 			if err := g.GenerateMock(ctx, &repo, plan); err != nil {
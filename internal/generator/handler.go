@@ -1 +1,153 @@
-package generator
\ No newline at end of file
+package generator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"gogen/internal/template"
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+func (g *Generator) GenerateHandler(ctx context.Context, handler *models.HandlerConfig, plan *models.GenerationPlan) error {
+
+	if len(handler.Endpoints) == 0 {
+		return fmt.Errorf("handler %s has no endpoints", handler.Name)
+	}
+
+	data := template.HandlerData{
+		Name:           handler.Name,
+		Package:        util.GetPackageName(g.config.Paths.Handler),
+		ModulePath:     plan.ModulePath,
+		UseCaseImport:  util.JoinModulePath(plan.ModulePath, g.config.Paths.UseCase),
+		UseCasePackage: util.GetPackageName(g.config.Paths.UseCase),
+		Route:          handler.Route,
+		AddComments:    handler.AddComments || g.config.Generation.AddComments,
+	}
+
+	for _, endpoint := range handler.Endpoints {
+		uc := plan.GetUseCaseByName(endpoint.UseCase)
+		if uc == nil {
+			return fmt.Errorf("use case %s not found for handler %s", endpoint.UseCase, handler.Name)
+		}
+
+		data.Endpoints = append(data.Endpoints, g.buildHandlerEndpoint(endpoint, uc, handler.Route))
+	}
+
+	content, err := g.renderer.Render("handler", data)
+	if err != nil {
+		return err
+	}
+
+	formatted, err := g.formatter.Format(content)
+	if err != nil {
+		return fmt.Errorf("generated code has syntax errors: %w", err)
+	}
+
+	withImports, err := g.imports.OrganizeImports(formatted)
+	if err != nil {
+		withImports = formatted
+	}
+
+	fileName := util.ToSnakeCase(handler.Name) + "_handler.go"
+	filePath := filepath.Join(g.config.Paths.Handler, fileName)
+
+	if err := g.write(output{
+		path:      filePath,
+		content:   withImports,
+		component: models.ComponentTypeHandler,
+		name:      handler.Name,
+		template:  "handler",
+	}, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *Generator) generateHandlerResponse(ctx context.Context, plan *models.GenerationPlan) error {
+
+	filePath := filepath.Join(g.config.Paths.Handler, "response.go")
+
+	if g.writer.Exists(filePath) {
+		return nil
+	}
+
+	data := template.HandlerResponseData{
+		Package:     util.GetPackageName(g.config.Paths.Handler),
+		ModulePath:  plan.ModulePath,
+		AddComments: g.config.Generation.AddComments,
+	}
+
+	content, err := g.renderer.Render("handler_response", data)
+	if err != nil {
+		return err
+	}
+
+	formatted, err := g.formatter.Format(content)
+	if err != nil {
+		return fmt.Errorf("generated code has syntax errors: %w", err)
+	}
+
+	if err := g.write(output{
+		path:      filePath,
+		content:   formatted,
+		component: models.ComponentTypeHandler,
+		name:      "response",
+		template:  "handler_response",
+	}, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (g *Generator) buildHandlerEndpoint(endpoint models.Endpoint, uc *models.UseCaseConfig, route string) template.HandlerEndpoint {
+	result := template.HandlerEndpoint{
+		Name:    uc.Name,
+		UseCase: uc.Name,
+		Field:   util.ToCamelCase(uc.Name),
+		Method:  endpoint.Method,
+		Path:    endpoint.Path,
+		Status:  "http.StatusOK",
+	}
+
+	switch endpoint.Method {
+	case http.MethodPost:
+		result.HasBody = true
+		if strings.TrimSuffix(endpoint.Path, "/") == strings.TrimSuffix(route, "/") {
+			result.Status = "http.StatusCreated"
+		}
+	case http.MethodPut, http.MethodPatch:
+		result.HasBody = true
+	case http.MethodDelete:
+		result.Status = "http.StatusNoContent"
+	}
+
+	for i := range uc.InputFields {
+		field := uc.InputFields[i]
+
+		if field.Name == "ID" && strings.Contains(endpoint.Path, "{id}") {
+			result.PathID = &field
+			continue
+		}
+
+		if !result.HasBody && isQueryParamType(field.Type) {
+			result.QueryFields = append(result.QueryFields, field)
+		}
+	}
+
+	return result
+}
+
+func isQueryParamType(typeName string) bool {
+	switch typeName {
+	case "string", "int", "int64", "bool", "float64":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,141 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"gogen/internal/template"
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+func (g *Generator) generateInMemorySupport(ctx context.Context, plan *models.GenerationPlan) error {
+	return g.generateSupportFile(g.config.Paths.InMemory, "inmemory", "inmemory.go",
+		models.ComponentTypeRepository, template.InMemorySupportData{
+			ModulePath:    plan.ModulePath,
+			ErrorHandling: g.errorHandling(),
+			AddComments:   g.config.Generation.AddComments,
+		})
+}
+
+func (g *Generator) generateInMemoryRepository(ctx context.Context, repo *models.RepositoryConfig, plan *models.GenerationPlan) error {
+	data, err := g.inMemoryData(repo, plan)
+	if err != nil {
+		return err
+	}
+
+	return g.renderInMemory(repo, plan, "repository_inmemory", "_repository.go", models.ComponentTypeRepository, data)
+}
+
+func (g *Generator) generateInMemoryTest(ctx context.Context, repo *models.RepositoryConfig, plan *models.GenerationPlan) error {
+	if err := applyEntityDefaults(repo, plan); err != nil {
+		return err
+	}
+
+	data, err := g.inMemoryData(repo, plan)
+	if err != nil {
+		return err
+	}
+
+	return g.renderInMemory(repo, plan, "test_inmemory", "_repository_test.go", models.ComponentTypeTest, data)
+}
+
+func (g *Generator) renderInMemory(repo *models.RepositoryConfig, plan *models.GenerationPlan, templateName, suffix string, component models.ComponentType, data template.InMemoryData) error {
+	content, err := g.renderer.Render(templateName, data)
+	if err != nil {
+		return err
+	}
+
+	formatted, err := g.formatter.Format(content)
+	if err != nil {
+		return fmt.Errorf("generated code has syntax errors: %w", err)
+	}
+
+	withImports, err := g.imports.OrganizeImports(formatted)
+	if err != nil {
+		withImports = formatted
+	}
+
+	return g.write(output{
+		path:      filepath.Join(g.config.Paths.InMemory, util.ToSnakeCase(repo.Name)+suffix),
+		content:   withImports,
+		component: component,
+		name:      repo.Name,
+		template:  templateName,
+	}, g.entityExists(repo.Entity, plan))
+}
+
+func (g *Generator) inMemoryData(repo *models.RepositoryConfig, plan *models.GenerationPlan) (template.InMemoryData, error) {
+	schema := repositorySchema(repo, plan)
+
+	data := template.InMemoryData{
+		RepositoryData: template.RepositoryData{
+			Name:        repo.Name,
+			Entity:      repo.Entity,
+			TableName:   repo.TableName,
+			ModulePath:  plan.ModulePath,
+			AddComments: repo.AddComments || g.config.Generation.AddComments,
+			Fields:      repo.Fields,
+			Key:         keyData(schema.Key, repo.Fields, "domain"),
+			Columns:     schema.Columns,
+			SoftDelete:  schema.SoftDelete,
+			Audit:       schema.Audit,
+			Timestamps:  schema.Timestamps,
+			Versioned:   schema.Versioned,
+			List:        listData(schema, "domain"),
+			Samples:     fieldSamples(repo.Fields, "domain"),
+		},
+		Lookup: "id",
+	}
+
+	if data.Key.Composite {
+		names := make([]string, len(data.Key.Params))
+		for i, p := range data.Key.Params {
+			names[i] = p.Name
+		}
+		data.Lookup = fmt.Sprintf("%sKey{%s}", util.ToCamelCase(repo.Name), strings.Join(names, ", "))
+	}
+
+	for _, field := range schema.Columns {
+		if field.Unique && !schema.Key.Contains(field) {
+			data.Unique = append(data.Unique, field)
+		}
+		if !models.HasColumn(schema.Updated(), field.DBTag) && !schema.Key.Contains(field) && field.DBTag != "version" {
+			data.Preserved = append(data.Preserved, field)
+		}
+	}
+	if entity := plan.GetEntityByName(repo.Entity); entity != nil {
+		data.UniqueKeys = entity.UniqueKeyFields()
+	}
+
+	data.CustomMethods = customMethods(repo, "domain")
+	for i, cm := range repo.CustomMethods {
+		method, err := deriveMethod(&cm, repo.Entity, schema)
+		if err != nil {
+			return template.InMemoryData{}, fmt.Errorf("method %s: %w", cm.Name, err)
+		}
+
+		if method == nil {
+			returns := make([]string, len(cm.Returns))
+			for j, r := range cm.Returns {
+				returns[j] = domainType(r, "domain")
+			}
+			data.CustomMethods[i].Body = stubBody(cm.Name, returns)
+			continue
+		}
+
+		q := &template.DerivedQuery{
+			Kind:  string(method.Kind),
+			Many:  method.Many,
+			Match: method.GoMatch("entity"),
+		}
+		for _, order := range method.OrderBy {
+			q.Order = append(q.Order, template.DerivedOrder{Field: order.Field.Name, Desc: order.Desc})
+		}
+		data.CustomMethods[i].Query = q
+	}
+
+	return data, nil
+}
@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"gogen/internal/template"
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+func keyData(key models.PrimaryKey, fields []models.Field, pkg string) template.KeyData {
+	data := template.KeyData{
+		Strategy:      string(key.Strategy),
+		Type:          key.Type(),
+		Composite:     key.Composite(),
+		AutoIncrement: key.AutoIncrement(),
+		Fields:        key.Fields,
+	}
+
+	switch key.Strategy {
+	case models.IDUUID:
+		data.Generate = "uuid.New()"
+	case models.IDULID:
+		data.Generate = "ulid.Make().String()"
+	}
+
+	for _, field := range key.Fields {
+		name := "id"
+		if key.Composite() {
+			name = util.ToCamelCase(field.Name)
+		}
+
+		data.Params = append(data.Params, template.MethodParam{
+			Name:   name,
+			Type:   domainType(field.Type, pkg),
+			Sample: keySample(field, fields, pkg),
+		})
+	}
+
+	return data
+}
+
+func trackingFields(entity *models.EntityConfig) []models.Field {
+	key := entity.PrimaryKey()
+
+	var fields []models.Field
+	for _, field := range entity.ImplicitFields() {
+		if !key.Contains(field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func tableColumns(entity *models.EntityConfig) []models.Field {
+	columns := make([]models.Field, 0, len(entity.Fields)+5)
+	columns = append(columns, entity.Fields...)
+	return append(columns, trackingFields(entity)...)
+}
+
+func keySample(field models.Field, fields []models.Field, pkg string) string {
+	switch field.Type {
+	case "uuid.UUID":
+		return "uuid.New()"
+	case "string":
+		return `"missing"`
+	case "int":
+		return "404"
+	case "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return field.Type + "(404)"
+	}
+	return enumSample(fields, field.Type, pkg)
+}
@@ -0,0 +1,48 @@
+package generator
+
+import (
+	"strings"
+
+	"gogen/internal/template"
+	"gogen/pkg/models"
+)
+
+func listData(schema models.Schema, pkg string) template.ListData {
+	data := template.ListData{
+		DefaultSort: listField(schema.DefaultSort(), pkg),
+	}
+
+	for _, field := range schema.SortFields() {
+		sortField := listField(field, pkg)
+		data.SortFields = append(data.SortFields, sortField)
+		if sortField.Nullable {
+			data.Nullable = append(data.Nullable, sortField)
+		}
+	}
+	for _, field := range schema.FilterFields() {
+		data.Filters = append(data.Filters, listField(field, pkg))
+	}
+	for _, field := range schema.Key.Fields {
+		data.Cursor = append(data.Cursor, listField(field, pkg))
+	}
+
+	return data
+}
+
+func listField(field models.Field, pkg string) template.ListField {
+	return template.ListField{
+		Name:     field.Name,
+		Column:   field.DBTag,
+		Type:     domainType(field.BaseType(), pkg),
+		Nullable: nullable(field),
+	}
+}
+
+// nullable reports whether a non-pointer field can still hold NULL: optional enums and
+// the sql.Null*/uuid.NullUUID wrappers.
+func nullable(field models.Field) bool {
+	if field.IsEnum() && !field.Required {
+		return true
+	}
+	return strings.HasPrefix(field.Type, "sql.Null") || field.Type == "uuid.NullUUID"
+}
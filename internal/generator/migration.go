@@ -0,0 +1,144 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"gogen/internal/dialect"
+	"gogen/internal/migration"
+	"gogen/pkg/models"
+)
+
+func (g *Generator) GenerateMigration(ctx context.Context, repo *models.RepositoryConfig, plan *models.GenerationPlan) error {
+
+	format := g.config.Migrations.Format
+	if format == migration.FormatNone || repo.SkipMigration || !dialect.IsSQL(repo.DBType) {
+		return nil
+	}
+
+	entity := plan.GetEntityByName(repo.Entity)
+	if entity == nil {
+		return nil
+	}
+
+	table := repo.TableName
+	if table == "" {
+		table = entity.TableName
+	}
+
+	dir := g.config.Paths.Migrations
+	if dir == "" {
+		dir = "migrations"
+	}
+
+	d, err := dialect.Get(repo.DBType)
+	if err != nil {
+		return err
+	}
+
+	if entity.Existing {
+		diff := migration.EntityDiff(entity)
+		if !diff.IsEmpty() {
+			version, err := g.nextMigrationVersion(dir)
+			if err != nil {
+				return err
+			}
+			m := migration.AlterTable(d, version, table, diff)
+			if m.Up != "" {
+				if err := g.writeMigration(dir, format, m); err != nil {
+					return err
+				}
+			}
+		}
+	} else {
+		created, err := g.migrationExists(dir, migration.CreateTableName(table))
+		if err != nil {
+			return err
+		}
+		if !created {
+			version, err := g.nextMigrationVersion(dir)
+			if err != nil {
+				return err
+			}
+			m := migration.CreateTable(d, version, table, entity.PrimaryKey(),
+				tableColumns(entity), entity.UniqueKeyFields(), entity.Relations)
+			if err := g.writeMigration(dir, format, m); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, rel := range entity.Relations {
+		if rel.Kind != models.RelationManyToMany || rel.Inverse {
+			continue
+		}
+
+		created, err := g.migrationExists(dir, migration.CreateTableName(rel.JoinTable))
+		if err != nil {
+			return err
+		}
+		if created {
+			continue
+		}
+
+		version, err := g.nextMigrationVersion(dir)
+		if err != nil {
+			return err
+		}
+		if err := g.writeMigration(dir, format, migration.CreateJoinTable(d, version, table, rel)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (g *Generator) migrationExists(dir, name string) (bool, error) {
+	existing, err := g.writer.Glob(filepath.Join(dir, "*_"+name+".*sql"))
+	if err != nil {
+		return false, err
+	}
+	return len(existing) > 0, nil
+}
+
+func (g *Generator) writeMigration(dir, format string, m migration.Migration) error {
+	files, err := m.Files(format)
+	if err != nil {
+		return err
+	}
+
+	for _, f := range files {
+		path := filepath.Join(dir, f.Name)
+		if err := g.writer.Write(path, f.Content, false); err != nil {
+			return fmt.Errorf("failed to write migration: %w", err)
+		}
+
+		g.record(output{path: path, component: models.ComponentTypeMigration, name: m.Name}, f.Content)
+	}
+
+	return nil
+}
+
+// nextMigrationVersion returns a version no earlier than now and later than every migration
+// in dir or issued in this run, so migrations apply in the order they were generated.
+func (g *Generator) nextMigrationVersion(dir string) (string, error) {
+	existing, err := g.writer.Glob(filepath.Join(dir, "*.sql"))
+	if err != nil {
+		return "", fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	next := time.Now()
+	if next.Before(g.migrationClock) {
+		next = g.migrationClock
+	}
+	next = next.UTC().Truncate(time.Second)
+
+	if latest, err := migration.ParseVersion(migration.LatestVersion(existing)); err == nil && !next.After(latest) {
+		next = latest.Add(time.Second)
+	}
+
+	g.migrationClock = next.Add(time.Second)
+	return migration.Version(next), nil
+}
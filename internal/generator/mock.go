@@ -12,13 +12,14 @@ import (
 
 func (g *Generator) GenerateMock(ctx context.Context, repo *models.RepositoryConfig, plan *models.GenerationPlan) error {
 
-	methods := g.collectRepositoryMethods(repo)
+	methods := g.collectRepositoryMethods(repo, repositorySchema(repo, plan))
 
 	data := template.MockData{
-		Name:       repo.Name,
-		Entity:     repo.Entity,
-		ModulePath: plan.ModulePath,
-		Methods:    methods,
+		Name:        repo.Name,
+		Entity:      repo.Entity,
+		ModulePath:  plan.ModulePath,
+		Methods:     methods,
+		AddComments: repo.AddComments || g.config.Generation.AddComments,
 	}
 
 	content, err := g.renderer.Render("mock", data)
@@ -39,14 +40,23 @@ func (g *Generator) GenerateMock(ctx context.Context, repo *models.RepositoryCon
 	fileName := util.ToSnakeCase(repo.Name) + "_repository_mock.go"
 	filePath := filepath.Join(g.config.Paths.Mocks, fileName)
 
-	if err := g.writer.Write(filePath, withImports, false); err != nil {
+	if err := g.write(output{
+		path:      filePath,
+		content:   withImports,
+		component: models.ComponentTypeMock,
+		name:      repo.Name,
+		template:  "mock",
+	}, g.entityExists(repo.Entity, plan)); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (g *Generator) collectRepositoryMethods(repo *models.RepositoryConfig) []template.MockMethod {
+func (g *Generator) collectRepositoryMethods(repo *models.RepositoryConfig, schema models.Schema) []template.MockMethod {
+	keyParams := append([]template.MethodParam{{Name: "ctx", Type: "context.Context"}},
+		keyData(schema.Key, repo.Fields, "domain").Params...)
+
 	methods := []template.MockMethod{
 
 		{
@@ -58,11 +68,16 @@ func (g *Generator) collectRepositoryMethods(repo *models.RepositoryConfig) []te
 			Return: []string{"error"},
 		},
 		{
-			Name: "GetByID",
+			Name: "Save",
 			Params: []template.MethodParam{
 				{Name: "ctx", Type: "context.Context"},
-				{Name: "id", Type: "string"},
+				{Name: "entity", Type: fmt.Sprintf("*domain.%s", repo.Entity)},
 			},
+			Return: []string{"error"},
+		},
+		{
+			Name:   "GetByID",
+			Params: keyParams,
 			Return: []string{fmt.Sprintf("*domain.%s", repo.Entity), "error"},
 		},
 		{
@@ -74,29 +89,32 @@ func (g *Generator) collectRepositoryMethods(repo *models.RepositoryConfig) []te
 			Return: []string{"error"},
 		},
 		{
-			Name: "Delete",
-			Params: []template.MethodParam{
-				{Name: "ctx", Type: "context.Context"},
-				{Name: "id", Type: "string"},
-			},
+			Name:   "Delete",
+			Params: keyParams,
 			Return: []string{"error"},
 		},
 		{
 			Name: "List",
 			Params: []template.MethodParam{
 				{Name: "ctx", Type: "context.Context"},
-				{Name: "limit", Type: "int"},
-				{Name: "offset", Type: "int"},
+				{Name: "params", Type: fmt.Sprintf("domain.%sListParams", repo.Name)},
 			},
-			Return: []string{fmt.Sprintf("[]*domain.%s", repo.Entity), "error"},
+			Return: []string{fmt.Sprintf("*domain.Page[domain.%s]", repo.Entity), "error"},
 		},
 	}
 
+	if schema.SoftDelete {
+		methods = append(methods,
+			template.MockMethod{Name: "Restore", Params: keyParams, Return: []string{"error"}},
+			template.MockMethod{Name: "HardDelete", Params: keyParams, Return: []string{"error"}},
+		)
+	}
+
 	for _, cm := range repo.CustomMethods {
 		method := template.MockMethod{
 			Name:   cm.Name,
 			Params: make([]template.MethodParam, 0, len(cm.Params)+1),
-			Return: cm.Returns,
+			Return: make([]string, 0, len(cm.Returns)),
 		}
 
 		method.Params = append(method.Params, template.MethodParam{
@@ -107,10 +125,14 @@ func (g *Generator) collectRepositoryMethods(repo *models.RepositoryConfig) []te
 		for _, p := range cm.Params {
 			method.Params = append(method.Params, template.MethodParam{
 				Name: p.Name,
-				Type: p.Type,
+				Type: domainType(p.Type, "domain"),
 			})
 		}
 
+		for _, r := range cm.Returns {
+			method.Return = append(method.Return, domainType(r, "domain"))
+		}
+
 		methods = append(methods, method)
 	}
 
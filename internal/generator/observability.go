@@ -0,0 +1,74 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+
+	"gogen/internal/template"
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+type observability struct {
+	Logging bool
+	Metrics bool
+	Tracing bool
+}
+
+func (o observability) any() bool {
+	return o.Logging || o.Metrics || o.Tracing
+}
+
+func (g *Generator) checkObservability() error {
+	cfg := g.config.Observability
+
+	switch cfg.Logging {
+	case "", models.ObservabilityNone, models.LoggingSlog:
+	default:
+		return fmt.Errorf("unsupported logging backend: %s", cfg.Logging)
+	}
+	switch cfg.Metrics {
+	case "", models.ObservabilityNone, models.MetricsPrometheus:
+	default:
+		return fmt.Errorf("unsupported metrics backend: %s", cfg.Metrics)
+	}
+	switch cfg.Tracing {
+	case "", models.ObservabilityNone, models.TracingOTel:
+	default:
+		return fmt.Errorf("unsupported tracing backend: %s", cfg.Tracing)
+	}
+
+	return nil
+}
+
+func (g *Generator) useCaseObservability(uc *models.UseCaseConfig) observability {
+	cfg := g.config.Observability
+
+	return observability{
+		Logging: uc.WithLogging && cfg.Logging == models.LoggingSlog,
+		Metrics: uc.WithMetrics && cfg.Metrics == models.MetricsPrometheus,
+		Tracing: (uc.WithLogging || uc.WithMetrics) && cfg.Tracing == models.TracingOTel,
+	}
+}
+
+func (g *Generator) generateObservability(ctx context.Context, plan *models.GenerationPlan) error {
+	var used observability
+	for i := range plan.UseCases {
+		o := g.useCaseObservability(&plan.UseCases[i])
+		used.Logging = used.Logging || o.Logging
+		used.Metrics = used.Metrics || o.Metrics
+		used.Tracing = used.Tracing || o.Tracing
+	}
+	if !used.any() {
+		return nil
+	}
+
+	return g.generateSupportFile(g.config.Paths.UseCase, "observability", "observability.go",
+		models.ComponentTypeUseCase, template.ObservabilityData{
+			TracerName:  util.JoinModulePath(plan.ModulePath, g.config.Paths.UseCase),
+			Logging:     used.Logging,
+			Metrics:     used.Metrics,
+			Tracing:     used.Tracing,
+			AddComments: g.config.Generation.AddComments,
+		})
+}
@@ -0,0 +1,46 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"gogen/internal/dialect"
+	"gogen/internal/template"
+	"gogen/pkg/models"
+)
+
+func relationQuery(cm models.CustomMethod, repo *models.RepositoryConfig, plan *models.GenerationPlan, dbType string) (*template.RelationQuery, error) {
+	if cm.Relation == "" {
+		return nil, nil
+	}
+
+	entity := plan.GetEntityByName(repo.Entity)
+	if entity == nil {
+		return nil, fmt.Errorf("entity %s not found", repo.Entity)
+	}
+
+	rel := entity.GetRelation(cm.Relation)
+	if rel == nil {
+		return nil, fmt.Errorf("entity %s has no relation %s", entity.Name, cm.Relation)
+	}
+
+	q := &template.RelationQuery{
+		Kind:     strings.ToLower(strings.TrimSuffix(cm.Name, rel.Name)),
+		Relation: *rel,
+	}
+	if len(cm.Params) > 1 {
+		q.IDs = cm.Params[1].Name
+	}
+
+	if dbType == dialect.MongoDB {
+		return q, nil
+	}
+
+	d, err := dialect.Get(dbType)
+	if err != nil {
+		return nil, err
+	}
+	q.Queries = dialect.BuildRelationQueries(d, *rel)
+
+	return q, nil
+}
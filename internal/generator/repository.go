@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 
+	"gogen/internal/dialect"
+	"gogen/internal/parser"
 	"gogen/internal/template"
 	"gogen/internal/util"
 	"gogen/pkg/models"
@@ -12,6 +15,28 @@ import (
 
 func (g *Generator) GenerateRepository(ctx context.Context, repo *models.RepositoryConfig, plan *models.GenerationPlan) error {
 
+	if err := applyEntityDefaults(repo, plan); err != nil {
+		return err
+	}
+
+	if g.config.Generation.SeparateInterfaces {
+		if err := g.generateRepositoryInterface(ctx, repo, plan); err != nil {
+			return fmt.Errorf("failed to generate repository interface: %w", err)
+		}
+	}
+
+	if err := g.generateRepositoryImpl(ctx, repo, plan); err != nil {
+		return fmt.Errorf("failed to generate repository implementation: %w", err)
+	}
+
+	if err := g.generateInMemoryRepository(ctx, repo, plan); err != nil {
+		return fmt.Errorf("failed to generate in-memory repository: %w", err)
+	}
+
+	return nil
+}
+
+func applyEntityDefaults(repo *models.RepositoryConfig, plan *models.GenerationPlan) error {
 	entity := plan.GetEntityByName(repo.Entity)
 	if entity == nil {
 		return fmt.Errorf("entity %s not found for repository %s", repo.Entity, repo.Name)
@@ -25,28 +50,23 @@ func (g *Generator) GenerateRepository(ctx context.Context, repo *models.Reposit
 		repo.TableName = entity.TableName
 	}
 
-	if g.config.Generation.SeparateInterfaces {
-		if err := g.generateRepositoryInterface(ctx, repo, plan); err != nil {
-			return fmt.Errorf("failed to generate repository interface: %w", err)
-		}
-	}
-
-	if err := g.generateRepositoryImpl(ctx, repo, plan); err != nil {
-		return fmt.Errorf("failed to generate repository implementation: %w", err)
-	}
-
 	return nil
 }
 
 func (g *Generator) generateRepositoryInterface(ctx context.Context, repo *models.RepositoryConfig, plan *models.GenerationPlan) error {
+	schema := repositorySchema(repo, plan)
+
 	data := template.RepositoryData{
 		Name:          repo.Name,
 		Entity:        repo.Entity,
 		TableName:     repo.TableName,
 		ModulePath:    plan.ModulePath,
-		CustomMethods: repo.CustomMethods,
+		CustomMethods: customMethods(repo, ""),
 		AddComments:   repo.AddComments || g.config.Generation.AddComments,
 		Fields:        repo.Fields,
+		Key:           keyData(schema.Key, repo.Fields, ""),
+		SoftDelete:    schema.SoftDelete,
+		List:          listData(schema, ""),
 	}
 
 	content, err := g.renderer.Render("repository_interface", data)
@@ -67,7 +87,13 @@ func (g *Generator) generateRepositoryInterface(ctx context.Context, repo *model
 	fileName := util.ToSnakeCase(repo.Name) + "_repository.go"
 	filePath := filepath.Join(g.config.Paths.Domain, fileName)
 
-	if err := g.writer.Write(filePath, withImports, false); err != nil {
+	if err := g.write(output{
+		path:      filePath,
+		content:   withImports,
+		component: models.ComponentTypeRepository,
+		name:      repo.Name,
+		template:  "repository_interface",
+	}, g.entityExists(repo.Entity, plan)); err != nil {
 		return err
 	}
 
@@ -76,24 +102,12 @@ func (g *Generator) generateRepositoryInterface(ctx context.Context, repo *model
 
 func (g *Generator) generateRepositoryImpl(ctx context.Context, repo *models.RepositoryConfig, plan *models.GenerationPlan) error {
 
-	dbType := repo.DBType
-	if dbType == "" {
-		dbType = "postgres"
-	}
-
-	data := template.RepositoryData{
-		Name:             repo.Name,
-		Entity:           repo.Entity,
-		TableName:        repo.TableName,
-		ModulePath:       plan.ModulePath,
-		DBType:           dbType,
-		CustomMethods:    repo.CustomMethods,
-		WithTransactions: repo.WithTransactions,
-		AddComments:      repo.AddComments || g.config.Generation.AddComments,
-		Fields:           repo.Fields,
+	data, templateName, err := g.repositoryImplData(repo, plan)
+	if err != nil {
+		return err
 	}
 
-	content, err := g.renderer.Render("repository_impl", data)
+	content, err := g.renderer.Render(templateName, data)
 	if err != nil {
 		return err
 	}
@@ -111,9 +125,149 @@ func (g *Generator) generateRepositoryImpl(ctx context.Context, repo *models.Rep
 	fileName := util.ToSnakeCase(repo.Name) + "_repository.go"
 	filePath := filepath.Join(g.config.Paths.Repository, fileName)
 
-	if err := g.writer.Write(filePath, withImports, false); err != nil {
+	if err := g.write(output{
+		path:      filePath,
+		content:   withImports,
+		component: models.ComponentTypeRepository,
+		name:      repo.Name,
+		template:  templateName,
+	}, g.entityExists(repo.Entity, plan)); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (g *Generator) repositoryImplData(repo *models.RepositoryConfig, plan *models.GenerationPlan) (template.RepositoryData, string, error) {
+	dbType := dialect.Normalize(repo.DBType)
+	templateName := "repository_impl"
+	schema := repositorySchema(repo, plan)
+
+	var queries dialect.Queries
+	if dbType == dialect.MongoDB {
+		templateName = "repository_impl_mongodb"
+	} else {
+		d, err := dialect.Get(dbType)
+		if err != nil {
+			return template.RepositoryData{}, "", err
+		}
+		queries = dialect.BuildQueries(d, schema)
+	}
+
+	methods := customMethods(repo, "domain")
+	for i, cm := range repo.CustomMethods {
+		q, err := derivedQuery(cm, repo, schema, dbType)
+		if err != nil {
+			return template.RepositoryData{}, "", fmt.Errorf("method %s: %w", cm.Name, err)
+		}
+		methods[i].Query = q
+
+		r, err := relationQuery(cm, repo, plan, dbType)
+		if err != nil {
+			return template.RepositoryData{}, "", fmt.Errorf("method %s: %w", cm.Name, err)
+		}
+		methods[i].Relation = r
+
+		for j, p := range cm.Params {
+			methods[i].Params[j].Sample = enumSample(repo.Fields, p.Type, "domain")
+		}
+	}
+
+	data := template.RepositoryData{
+		Name:             repo.Name,
+		Entity:           repo.Entity,
+		TableName:        repo.TableName,
+		ModulePath:       plan.ModulePath,
+		DBType:           dbType,
+		Queries:          queries,
+		CustomMethods:    methods,
+		WithTransactions: repo.WithTransactions,
+		AddComments:      repo.AddComments || g.config.Generation.AddComments,
+		Fields:           repo.Fields,
+		Key:              keyData(schema.Key, repo.Fields, "domain"),
+		Columns:          schema.Columns,
+		InsertFields:     schema.Inserted(),
+		UpdateFields:     schema.Updated(),
+		SoftDelete:       schema.SoftDelete,
+		Audit:            schema.Audit,
+		Timestamps:       schema.Timestamps,
+		Versioned:        schema.Versioned,
+		List:             listData(schema, "domain"),
+		Samples:          fieldSamples(repo.Fields, "domain"),
+	}
+
+	return data, templateName, nil
+}
+
+func customMethods(repo *models.RepositoryConfig, pkg string) []template.CustomMethod {
+	methods := make([]template.CustomMethod, 0, len(repo.CustomMethods))
+
+	for _, cm := range repo.CustomMethods {
+		method := template.CustomMethod{
+			Name:    cm.Name,
+			Comment: cm.Comment,
+			Params:  make([]template.MethodParam, 0, len(cm.Params)),
+			Body:    cm.Body,
+		}
+
+		for _, p := range cm.Params {
+			method.Params = append(method.Params, template.MethodParam{
+				Name: p.Name,
+				Type: domainType(p.Type, pkg),
+			})
+		}
+
+		returns := make([]string, 0, len(cm.Returns))
+		for _, r := range cm.Returns {
+			returns = append(returns, domainType(r, pkg))
+		}
+		method.Return = resultList(returns, cm.ResultNames)
+
+		if method.Body == "" {
+			method.Body = stubBody(cm.Name, returns)
+		}
+
+		methods = append(methods, method)
+	}
+
+	return methods
+}
+
+func domainType(typeName, pkg string) string {
+	if pkg == "" {
+		return parser.UnqualifyType(typeName, "domain")
+	}
+	return parser.QualifyType(typeName, pkg)
+}
+
+func resultList(types, names []string) string {
+	if len(names) == len(types) && len(names) > 0 {
+		results := make([]string, len(types))
+		for i := range types {
+			results[i] = names[i] + " " + types[i]
+		}
+		return "(" + strings.Join(results, ", ") + ")"
+	}
+
+	if len(types) == 1 {
+		return types[0]
+	}
+	return "(" + strings.Join(types, ", ") + ")"
+}
+
+func stubBody(name string, returns []string) string {
+	message := name + " is not implemented"
+
+	if len(returns) == 0 || returns[len(returns)-1] != "error" {
+		return fmt.Sprintf("panic(%q)", message)
+	}
+
+	var lines, values []string
+	for i, typ := range returns[:len(returns)-1] {
+		lines = append(lines, fmt.Sprintf("var result%d %s", i, typ))
+		values = append(values, fmt.Sprintf("result%d", i))
+	}
+	values = append(values, fmt.Sprintf("fmt.Errorf(%q)", message))
+
+	return strings.Join(append(lines, "return "+strings.Join(values, ", ")), "\n")
+}
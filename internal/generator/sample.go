@@ -0,0 +1,92 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"gogen/internal/template"
+	"gogen/pkg/models"
+)
+
+func fieldSamples(fields []models.Field, pkg string) []template.FieldSample {
+	var samples []template.FieldSample
+	for _, field := range fields {
+		if value := fieldSample(field, pkg); value != "" {
+			samples = append(samples, template.FieldSample{Name: field.Name, Value: value})
+		}
+	}
+	return samples
+}
+
+// fieldSample returns a literal that passes the field's validation rules,
+// or "" when the zero value has to do.
+func fieldSample(field models.Field, pkg string) string {
+	if field.IsPointer() {
+		return ""
+	}
+	if field.IsEnum() {
+		return domainType(enumConstant(field.BaseType(), field.Enum[0]), pkg)
+	}
+
+	var oneOf []string
+	size, lower, upper := -1.0, -1.0, -1.0
+	for _, rule := range field.Rules {
+		param, _ := strconv.ParseFloat(rule.Param, 64)
+		switch rule.Kind {
+		case models.RuleEmail:
+			return `"user@example.com"`
+		case models.RuleURL:
+			return `"https://example.com"`
+		case models.RuleOneOf:
+			oneOf = rule.Values()
+		case models.RuleLen:
+			size = param
+		case models.RuleMin:
+			lower = param
+		case models.RuleMax:
+			upper = param
+		}
+	}
+
+	switch field.ValueKind() {
+	case "string":
+		if len(oneOf) > 0 {
+			return strconv.Quote(oneOf[0])
+		}
+		if size < 0 {
+			size = 6
+			if lower > size {
+				size = lower
+			}
+			if upper >= 0 && upper < size {
+				size = upper
+			}
+		}
+		if size == 6 {
+			return `"sample"`
+		}
+		return strconv.Quote(strings.Repeat("a", int(size)))
+	case "int", "uint", "float":
+		if len(oneOf) > 0 {
+			return oneOf[0]
+		}
+		value := 1.0
+		if lower > value {
+			value = lower
+		}
+		if upper >= 0 && upper < value {
+			value = upper
+		}
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	}
+
+	switch field.Type {
+	case "bool":
+		return "true"
+	case "time.Time":
+		return "time.Now()"
+	case "uuid.UUID":
+		return "uuid.New()"
+	}
+	return ""
+}
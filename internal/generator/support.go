@@ -0,0 +1,136 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"gogen/internal/dialect"
+	"gogen/internal/template"
+	"gogen/pkg/models"
+)
+
+func (g *Generator) generateAudit(ctx context.Context, plan *models.GenerationPlan) error {
+	return g.generateDomainFile("audit", "audit.go", template.AuditData{
+		AddComments: g.config.Generation.AddComments,
+	})
+}
+
+func (g *Generator) generateErrors(ctx context.Context, plan *models.GenerationPlan) error {
+	return g.generateDomainFile("errors", "errors.go", template.ErrorsData{
+		AddComments: g.config.Generation.AddComments,
+	})
+}
+
+func (g *Generator) errorHandling() string {
+	if g.config.Generation.ErrorHandling == "" {
+		return models.ErrorHandlingWrap
+	}
+	return g.config.Generation.ErrorHandling
+}
+
+func (g *Generator) checkErrorHandling() error {
+	switch g.errorHandling() {
+	case models.ErrorHandlingWrap, models.ErrorHandlingReturn, models.ErrorHandlingPanic:
+		return nil
+	default:
+		return fmt.Errorf("unsupported error handling strategy: %s", g.config.Generation.ErrorHandling)
+	}
+}
+
+func (g *Generator) generateRepositoryErrors(ctx context.Context, plan *models.GenerationPlan) error {
+	data := template.RepositoryErrorsData{
+		ModulePath:    plan.ModulePath,
+		ErrorHandling: g.errorHandling(),
+		AddComments:   g.config.Generation.AddComments,
+	}
+	for _, repo := range plan.Repositories {
+		switch dialect.Normalize(repo.DBType) {
+		case dialect.Postgres:
+			data.Postgres = true
+		case dialect.MySQL:
+			data.MySQL = true
+		case dialect.SQLite:
+			data.SQLite = true
+		case dialect.MongoDB:
+			data.MongoDB = true
+		}
+	}
+
+	return g.generateSupportFile(g.config.Paths.Repository, "repository_errors", "errors.go",
+		models.ComponentTypeRepository, data)
+}
+
+func (g *Generator) generateUseCaseErrors(ctx context.Context, plan *models.GenerationPlan) error {
+	return g.generateSupportFile(g.config.Paths.UseCase, "usecase_errors", "errors.go",
+		models.ComponentTypeUseCase, template.UseCaseErrorsData{
+			ModulePath:    plan.ModulePath,
+			ErrorHandling: g.errorHandling(),
+			AddComments:   g.config.Generation.AddComments,
+		})
+}
+
+func (g *Generator) generatePagination(ctx context.Context, plan *models.GenerationPlan) error {
+	return g.generateDomainFile("pagination", "pagination.go", template.PaginationData{
+		AddComments: g.config.Generation.AddComments,
+	})
+}
+
+func (g *Generator) generateTransactions(ctx context.Context, plan *models.GenerationPlan) error {
+	data := template.TransactionData{
+		ModulePath:  plan.ModulePath,
+		AddComments: g.config.Generation.AddComments,
+	}
+	for _, repo := range plan.Repositories {
+		if !repo.WithTransactions {
+			continue
+		}
+		if dialect.Normalize(repo.DBType) == dialect.MongoDB {
+			data.MongoDB = true
+		} else {
+			data.SQL = true
+		}
+	}
+
+	if err := g.generateDomainFile("transaction", "transaction.go", data); err != nil {
+		return err
+	}
+
+	if data.SQL || data.MongoDB {
+		if err := g.generateSupportFile(g.config.Paths.Repository, "tx_manager", "tx_manager.go",
+			models.ComponentTypeRepository, data); err != nil {
+			return err
+		}
+	}
+
+	if plan.WithMocks {
+		return g.generateSupportFile(g.config.Paths.Mocks, "tx_manager_mock", "tx_manager_mock.go",
+			models.ComponentTypeMock, data)
+	}
+
+	return nil
+}
+
+func (g *Generator) generateDomainFile(templateName, fileName string, data interface{}) error {
+	return g.generateSupportFile(g.config.Paths.Domain, templateName, fileName, models.ComponentTypeEntity, data)
+}
+
+func (g *Generator) generateSupportFile(dir, templateName, fileName string, component models.ComponentType, data interface{}) error {
+	content, err := g.renderer.Render(templateName, data)
+	if err != nil {
+		return err
+	}
+
+	formatted, err := g.formatter.Format(content)
+	if err != nil {
+		return fmt.Errorf("generated code has syntax errors: %w", err)
+	}
+
+	return g.write(output{
+		path:      filepath.Join(dir, fileName),
+		content:   formatted,
+		component: component,
+		name:      templateName,
+		template:  templateName,
+	}, true)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"gogen/internal/dialect"
 	"gogen/internal/template"
 	"gogen/internal/util"
 	"gogen/pkg/models"
@@ -13,6 +14,9 @@ import (
 func (g *Generator) GenerateTests(ctx context.Context, plan *models.GenerationPlan) error {
 
 	for _, entity := range plan.Entities {
+		if entity.Reused {
+			continue
+		}
 		if err := g.generateEntityTest(ctx, &entity, plan); err != nil {
 			return fmt.Errorf("failed to generate test for entity %s: %w", entity.Name, err)
 		}
@@ -22,6 +26,9 @@ func (g *Generator) GenerateTests(ctx context.Context, plan *models.GenerationPl
 		if err := g.generateRepositoryTest(ctx, &repo, plan); err != nil {
 			return fmt.Errorf("failed to generate test for repository %s: %w", repo.Name, err)
 		}
+		if err := g.generateInMemoryTest(ctx, &repo, plan); err != nil {
+			return fmt.Errorf("failed to generate in-memory test for repository %s: %w", repo.Name, err)
+		}
 	}
 
 	for _, uc := range plan.UseCases {
@@ -37,10 +44,16 @@ func (g *Generator) generateEntityTest(ctx context.Context, entity *models.Entit
 	data := struct {
 		Name       string
 		Fields     []models.Field
+		Enums      []template.EnumData
+		Key        template.KeyData
+		Timestamps bool
 		ModulePath string
 	}{
 		Name:       entity.Name,
 		Fields:     entity.Fields,
+		Enums:      entityEnums(entity.Fields),
+		Key:        keyData(entity.PrimaryKey(), entity.Fields, ""),
+		Timestamps: !entity.NoTimestamps,
 		ModulePath: plan.ModulePath,
 	}
 
@@ -62,7 +75,13 @@ func (g *Generator) generateEntityTest(ctx context.Context, entity *models.Entit
 	fileName := util.ToSnakeCase(entity.Name) + "_test.go"
 	filePath := filepath.Join(g.config.Paths.Domain, fileName)
 
-	if err := g.writer.Write(filePath, withImports, false); err != nil {
+	if err := g.write(output{
+		path:      filePath,
+		content:   withImports,
+		component: models.ComponentTypeTest,
+		name:      entity.Name,
+		template:  "test_entity",
+	}, entity.Existing); err != nil {
 		return err
 	}
 
@@ -70,16 +89,17 @@ func (g *Generator) generateEntityTest(ctx context.Context, entity *models.Entit
 }
 
 func (g *Generator) generateRepositoryTest(ctx context.Context, repo *models.RepositoryConfig, plan *models.GenerationPlan) error {
-	data := struct {
-		Name       string
-		Entity     string
-		ModulePath string
-		Methods    []template.MockMethod
-	}{
-		Name:       repo.Name,
-		Entity:     repo.Entity,
-		ModulePath: plan.ModulePath,
-		Methods:    g.collectRepositoryMethods(repo),
+	if !dialect.IsSQL(repo.DBType) {
+		return nil
+	}
+
+	if err := applyEntityDefaults(repo, plan); err != nil {
+		return err
+	}
+
+	data, _, err := g.repositoryImplData(repo, plan)
+	if err != nil {
+		return err
 	}
 
 	content, err := g.renderer.Render("test_repository", data)
@@ -100,7 +120,13 @@ func (g *Generator) generateRepositoryTest(ctx context.Context, repo *models.Rep
 	fileName := util.ToSnakeCase(repo.Name) + "_repository_test.go"
 	filePath := filepath.Join(g.config.Paths.Repository, fileName)
 
-	if err := g.writer.Write(filePath, withImports, false); err != nil {
+	if err := g.write(output{
+		path:      filePath,
+		content:   withImports,
+		component: models.ComponentTypeTest,
+		name:      repo.Name,
+		template:  "test_repository",
+	}, g.entityExists(repo.Entity, plan)); err != nil {
 		return err
 	}
 
@@ -108,18 +134,28 @@ func (g *Generator) generateRepositoryTest(ctx context.Context, repo *models.Rep
 }
 
 func (g *Generator) generateUseCaseTest(ctx context.Context, uc *models.UseCaseConfig, plan *models.GenerationPlan) error {
+	observed := g.useCaseObservability(uc)
+
 	data := struct {
-		Name         string
-		ModulePath   string
-		Dependencies []models.Dependency
-		InputFields  []models.Field
-		OutputFields []models.Field
+		Name          string
+		ModulePath    string
+		Dependencies  []models.Dependency
+		InputFields   []models.Field
+		OutputFields  []models.Field
+		Transactional bool
+		WithLogging   bool
+		WithMetrics   bool
+		CRUD          *template.CRUDData
 	}{
-		Name:         uc.Name,
-		ModulePath:   plan.ModulePath,
-		Dependencies: uc.Dependencies,
-		InputFields:  uc.InputFields,
-		OutputFields: uc.OutputFields,
+		Name:          uc.Name,
+		ModulePath:    plan.ModulePath,
+		Dependencies:  uc.Dependencies,
+		InputFields:   uc.InputFields,
+		OutputFields:  uc.OutputFields,
+		Transactional: uc.Transactional,
+		WithLogging:   observed.Logging,
+		WithMetrics:   observed.Metrics,
+		CRUD:          g.crudData(uc, plan),
 	}
 
 	content, err := g.renderer.Render("test_usecase", data)
@@ -140,7 +176,13 @@ func (g *Generator) generateUseCaseTest(ctx context.Context, uc *models.UseCaseC
 	fileName := util.ToSnakeCase(uc.Name) + "_usecase_test.go"
 	filePath := filepath.Join(g.config.Paths.UseCase, fileName)
 
-	if err := g.writer.Write(filePath, withImports, false); err != nil {
+	if err := g.write(output{
+		path:      filePath,
+		content:   withImports,
+		component: models.ComponentTypeTest,
+		name:      uc.Name,
+		template:  "test_usecase",
+	}, false); err != nil {
 		return err
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"strings"
 
 	"gogen/internal/template"
 	"gogen/internal/util"
@@ -16,7 +17,7 @@ func (g *Generator) GenerateUseCase(ctx context.Context, uc *models.UseCaseConfi
 		dep := &uc.Dependencies[i]
 
 		if dep.Type == "repository" || dep.Type == "" {
-			repoName := util.TrimSuffix(dep.Name, "Repository")
+			repoName := strings.TrimSuffix(dep.Name, "Repository")
 			dep.Found = plan.HasRepository(repoName)
 		}
 	}
@@ -26,17 +27,33 @@ func (g *Generator) GenerateUseCase(ctx context.Context, uc *models.UseCaseConfi
 		return fmt.Errorf("use case %s has missing dependencies: %v", uc.Name, missing)
 	}
 
+	observed := g.useCaseObservability(uc)
+
 	data := template.UseCaseData{
-		Name:         uc.Name,
-		Description:  uc.Description,
-		ModulePath:   plan.ModulePath,
-		Dependencies: uc.Dependencies,
-		InputFields:  uc.InputFields,
-		OutputFields: uc.OutputFields,
-		WithLogging:  uc.WithLogging,
-		WithMetrics:  uc.WithMetrics,
-		AddComments:  uc.AddComments || g.config.Generation.AddComments,
-		Example:      uc.Example,
+		Name:          uc.Name,
+		Description:   uc.Description,
+		ModulePath:    plan.ModulePath,
+		InputFields:   uc.InputFields,
+		OutputFields:  uc.OutputFields,
+		WithLogging:   observed.Logging,
+		WithMetrics:   observed.Metrics,
+		WithTracing:   observed.Tracing,
+		Transactional: uc.Transactional,
+		CRUD:          g.crudData(uc, plan),
+		AddComments:   uc.AddComments || g.config.Generation.AddComments,
+		Example:       uc.Example,
+	}
+
+	if data.CRUD != nil {
+		data.InputFields = crudFields(uc.InputFields)
+		data.OutputFields = crudFields(uc.OutputFields)
+	}
+
+	for _, dep := range uc.Dependencies {
+		data.Dependencies = append(data.Dependencies, template.Dependency{
+			Name:  strings.TrimSuffix(dep.Name, "Repository"),
+			Found: dep.Found,
+		})
 	}
 
 	if data.Description == "" {
@@ -61,7 +78,13 @@ func (g *Generator) GenerateUseCase(ctx context.Context, uc *models.UseCaseConfi
 	fileName := util.ToSnakeCase(uc.Name) + "_usecase.go"
 	filePath := filepath.Join(g.config.Paths.UseCase, fileName)
 
-	if err := g.writer.Write(filePath, withImports, false); err != nil {
+	if err := g.write(output{
+		path:      filePath,
+		content:   withImports,
+		component: models.ComponentTypeUseCase,
+		name:      uc.Name,
+		template:  "usecase",
+	}, false); err != nil {
 		return err
 	}
 
@@ -0,0 +1,181 @@
+package generator
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"gogen/internal/template"
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+func (g *Generator) validationStyle() (string, error) {
+	switch style := g.config.Generation.Validation; style {
+	case "":
+		return models.ValidationMethods, nil
+	case models.ValidationMethods, models.ValidationTags, models.ValidationBoth:
+		return style, nil
+	default:
+		return "", fmt.Errorf("unsupported validation style: %s", style)
+	}
+}
+
+func (g *Generator) generateValidation(ctx context.Context, plan *models.GenerationPlan) error {
+	style, err := g.validationStyle()
+	if err != nil {
+		return err
+	}
+
+	data := template.ValidationData{
+		Tags:        style == models.ValidationTags,
+		AddComments: g.config.Generation.AddComments,
+	}
+
+	content, err := g.renderer.Render("validation", data)
+	if err != nil {
+		return err
+	}
+
+	formatted, err := g.formatter.Format(content)
+	if err != nil {
+		return fmt.Errorf("generated code has syntax errors: %w", err)
+	}
+
+	withImports, err := g.imports.OrganizeImports(formatted)
+	if err != nil {
+		withImports = formatted
+	}
+
+	return g.write(output{
+		path:      filepath.Join(g.config.Paths.Domain, "validation.go"),
+		content:   withImports,
+		component: models.ComponentTypeEntity,
+		name:      "validation",
+		template:  "validation",
+	}, true)
+}
+
+func validationChecks(entity string, fields []models.Field, style string) ([]template.ValidationCheck, []template.ValidationPattern) {
+	var checks []template.ValidationCheck
+	var patterns []template.ValidationPattern
+
+	for i := range fields {
+		field := &fields[i]
+		ref := "e." + field.Name
+
+		if field.Required && style != models.ValidationTags {
+			checks = append(checks, template.ValidationCheck{
+				Field:     field.Name,
+				Rule:      "required",
+				Condition: ref + " == " + field.ZeroValue(),
+				Message:   "is required",
+			})
+		}
+
+		value, guard := ref, ""
+		switch {
+		case field.IsPointer():
+			value, guard = "*"+ref, ref+" != nil && "
+		case field.Required:
+		case field.ValueKind() == "string":
+			guard = ref + ` != "" && `
+		case field.ValueKind() == "collection":
+			guard = "len(" + ref + ") > 0 && "
+		case field.ValueKind() != "":
+			guard = ref + " != 0 && "
+		}
+
+		for _, rule := range field.Rules {
+			if style == models.ValidationTags && rule.Tag() != "" {
+				continue
+			}
+
+			condition, message := ruleCondition(field, rule, value)
+			if rule.Kind == models.RuleRegex {
+				pattern := template.ValidationPattern{
+					Name: util.ToCamelCase(entity) + field.Name + "Pattern",
+					Expr: rule.Param,
+				}
+				patterns = append(patterns, pattern)
+				condition = "!" + pattern.Name + ".MatchString(" + value + ")"
+			}
+
+			if rule.Kind != models.RuleMax || field.IsPointer() || field.ValueKind() == "int" || field.ValueKind() == "float" {
+				condition = guard + condition
+			}
+
+			checks = append(checks, template.ValidationCheck{
+				Field:     field.Name,
+				Rule:      string(rule.Kind),
+				Condition: condition,
+				Message:   message,
+			})
+		}
+
+		if field.IsEnum() {
+			condition := ref + ".Validate() != nil"
+			if field.IsPointer() {
+				condition = ref + " != nil && " + condition
+			}
+			checks = append(checks, template.ValidationCheck{
+				Field:     field.Name,
+				Rule:      string(models.RuleOneOf),
+				Condition: condition,
+				Message:   "must be one of " + strings.Join(field.Enum, ", "),
+			})
+		}
+	}
+
+	return checks, patterns
+}
+
+func ruleCondition(field *models.Field, rule models.ValidationRule, value string) (string, string) {
+	kind := field.ValueKind()
+
+	size, unit := value, ""
+	switch kind {
+	case "string":
+		size, unit = "utf8.RuneCountInString("+value+")", " characters"
+	case "collection":
+		size, unit = "len("+value+")", " items"
+	}
+
+	switch rule.Kind {
+	case models.RuleMin:
+		if kind == "collection" {
+			return size + " < " + rule.Param, "must contain at least " + rule.Param + unit
+		}
+		return size + " < " + rule.Param, "must be at least " + rule.Param + unit
+	case models.RuleMax:
+		if kind == "collection" {
+			return size + " > " + rule.Param, "must contain at most " + rule.Param + unit
+		}
+		return size + " > " + rule.Param, "must be at most " + rule.Param + unit
+	case models.RuleLen:
+		if kind == "collection" {
+			return size + " != " + rule.Param, "must contain exactly " + rule.Param + unit
+		}
+		return size + " != " + rule.Param, "must be exactly " + rule.Param + unit
+	case models.RuleEmail:
+		return "!validEmail(" + value + ")", "must be a valid email address"
+	case models.RuleURL:
+		return "!validURL(" + value + ")", "must be a valid URL"
+	case models.RuleRegex:
+		return "", "must match " + rule.Param
+	case models.RuleOneOf:
+		values := rule.Values()
+		args := make([]string, len(values))
+		for i, v := range values {
+			args[i] = v
+			if kind == "string" {
+				args[i] = strconv.Quote(v)
+			}
+		}
+		return "!oneOf(" + value + ", " + strings.Join(args, ", ") + ")", "must be one of " + strings.Join(values, ", ")
+	default:
+		return "false", ""
+	}
+}
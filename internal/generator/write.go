@@ -0,0 +1,88 @@
+package generator
+
+import (
+	"fmt"
+	"time"
+
+	"gogen/internal/file"
+	"gogen/internal/manifest"
+	"gogen/pkg/models"
+)
+
+type output struct {
+	path      string
+	content   string
+	component models.ComponentType
+	name      string
+	template  string
+	entity    *models.EntityConfig
+}
+
+func (g *Generator) write(out output, overwrite bool) error {
+	content := out.content
+
+	if g.writer.Exists(out.path) && (overwrite || g.writer.CanOverwrite(out.path)) {
+		existing, err := g.writer.Read(out.path)
+		if err != nil {
+			return err
+		}
+
+		merged, err := file.Merge(existing, content, g.regionHashes(out.path))
+		if err != nil {
+			return fmt.Errorf("failed to preserve user code in %s: %w", out.path, err)
+		}
+
+		if organized, err := g.imports.OrganizeImports(merged); err == nil {
+			merged = organized
+		}
+
+		content = merged
+		overwrite = true
+	}
+
+	if err := g.writer.Write(out.path, content, overwrite); err != nil {
+		return err
+	}
+
+	g.record(out, content)
+
+	return nil
+}
+
+func (g *Generator) record(out output, content string) {
+	if g.manifest == nil {
+		return
+	}
+
+	entry := manifest.Entry{
+		Path:        out.path,
+		Component:   out.component,
+		Name:        out.name,
+		Template:    out.template,
+		OutputHash:  manifest.Hash([]byte(content)),
+		Regions:     file.RegionHashes(out.content),
+		GeneratedAt: time.Now().UTC(),
+		Entity:      out.entity,
+	}
+
+	if out.template != "" {
+		if source, err := g.renderer.Source(out.template); err == nil {
+			entry.TemplateHash = manifest.Hash(source)
+		}
+	}
+
+	g.manifest.Record(entry)
+}
+
+func (g *Generator) regionHashes(path string) map[string]string {
+	if g.manifest == nil {
+		return nil
+	}
+
+	entry, ok := g.manifest.Get(path)
+	if !ok {
+		return nil
+	}
+
+	return entry.Regions
+}
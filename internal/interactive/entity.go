@@ -1 +1,53 @@
-package interactive
\ No newline at end of file
+package interactive
+
+import (
+	"github.com/AlecAivazis/survey/v2"
+
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+func newEntity(name string) models.EntityConfig {
+	return models.EntityConfig{
+		Name:          name,
+		TableName:     util.ToSnakeCase(util.Pluralize(name)),
+		AddValidation: true,
+		AddComments:   true,
+		JSONStyle:     "snake_case",
+	}
+}
+
+func (w *Wizard) promptEntities(plan *models.GenerationPlan) error {
+	for {
+		name, err := w.askName("Имя новой сущности (пустая строка для завершения):", "")
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			return nil
+		}
+
+		if plan.HasEntity(name) {
+			w.logger.Warn("Сущность %s уже добавлена", name)
+			continue
+		}
+
+		entity := newEntity(name)
+
+		fields, err := NewFieldsPrompter().PromptFields()
+		if err != nil {
+			return err
+		}
+		entity.Fields = fields
+
+		if err := w.ask(&survey.Input{
+			Message: "Название таблицы в БД:",
+			Default: entity.TableName,
+		}, &entity.TableName); err != nil {
+			return err
+		}
+
+		plan.Entities = append(plan.Entities, entity)
+		w.logger.Success("Сущность %s добавлена", name)
+	}
+}
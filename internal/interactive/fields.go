@@ -22,10 +22,15 @@ func NewFieldsPrompter() *FieldsPrompter {
 }
 
 func (fp *FieldsPrompter) PromptFields() ([]models.Field, error) {
+	flags, rules, relations := parser.FieldTags()
+
 	fmt.Println("\nВведите поля (формат: Name:Type или Name:Type:tags)")
-	fmt.Println("Доступные теги: required, unique, index")
-	fmt.Println("Пример: Email:string:required,unique")
-	fmt.Println("Пустая строка для завершения\n")
+	fmt.Printf("Доступные теги: %s\n", strings.Join(flags, ", "))
+	fmt.Printf("Правила валидации: %s (значение через =, например min=3, oneof=a|b)\n", strings.Join(rules, ", "))
+	fmt.Printf("Связи: %s\n", strings.Join(relations, ", "))
+	fmt.Println("Перечисление: Status:enum(pending|paid) или Status:OrderStatus(pending|paid)")
+	fmt.Println("Пример: Email:string:required,unique,email")
+	fmt.Print("Пустая строка для завершения\n\n")
 
 	var fields []models.Field
 	i := 1
@@ -24,6 +24,9 @@ func (i *Interactor) EnhancePlan(plan *models.GenerationPlan, cfg *models.Config
 
 	for idx := range plan.Entities {
 		entity := &plan.Entities[idx]
+		if entity.Reused {
+			continue
+		}
 
 		if err := i.configureEntity(entity, cfg); err != nil {
 			return err
@@ -101,19 +104,8 @@ func (i *Interactor) configureRepository(repo *models.RepositoryConfig, plan *mo
 	}, &dbType)
 	repo.DBType = dbType
 
-	addCustom := false
-	survey.AskOne(&survey.Confirm{
-		Message: "Добавить кастомные методы репозитория?",
-		Default: false,
-	}, &addCustom)
-
-	if addCustom {
-		methodsPrompter := NewMethodsPrompter()
-		methods, err := methodsPrompter.PromptMethods()
-		if err != nil {
-			return err
-		}
-		repo.CustomMethods = methods
+	if err := promptCustomMethods(survey.AskOne, repo); err != nil {
+		return err
 	}
 
 	survey.AskOne(&survey.Confirm{
@@ -137,46 +129,16 @@ func (i *Interactor) configureUseCase(uc *models.UseCaseConfig, plan *models.Gen
 	}, &description)
 	uc.Description = description
 
-	addInput := false
-	survey.AskOne(&survey.Confirm{
-		Message: "Определить входные параметры (Input)?",
-		Default: len(uc.InputFields) == 0,
-	}, &addInput)
-
-	if addInput {
-		fieldsPrompter := NewFieldsPrompter()
-		fields, err := fieldsPrompter.PromptFields()
-		if err != nil {
+	if uc.CRUD == "" {
+		if err := promptUseCaseFields(survey.AskOne, uc); err != nil {
 			return err
 		}
-		uc.InputFields = fields
 	}
 
-	addOutput := false
-	survey.AskOne(&survey.Confirm{
-		Message: "Определить выходные параметры (Output)?",
-		Default: len(uc.OutputFields) == 0,
-	}, &addOutput)
-
-	if addOutput {
-		fieldsPrompter := NewFieldsPrompter()
-		fields, err := fieldsPrompter.PromptFields()
-		if err != nil {
-			return err
-		}
-		uc.OutputFields = fields
+	if err := promptUseCaseFlags(survey.AskOne, uc); err != nil {
+		return err
 	}
 
-	survey.AskOne(&survey.Confirm{
-		Message: "Добавить логирование?",
-		Default: false,
-	}, &uc.WithLogging)
-
-	survey.AskOne(&survey.Confirm{
-		Message: "Добавить метрики?",
-		Default: false,
-	}, &uc.WithMetrics)
-
 	i.logger.Success("Use case %s настроен", uc.Name)
 	fmt.Println()
 
@@ -190,6 +152,7 @@ func (i *Interactor) confirmGeneration(plan *models.GenerationPlan) error {
 	fmt.Printf("  • Сущностей: %d\n", len(plan.Entities))
 	fmt.Printf("  • Репозиториев: %d\n", len(plan.Repositories))
 	fmt.Printf("  • Use Cases: %d\n", len(plan.UseCases))
+	fmt.Printf("  • Handlers: %d\n", len(plan.Handlers))
 
 	if plan.WithTests {
 		fmt.Println("  • Тесты для всех компонентов")
@@ -6,20 +6,26 @@ import (
 
 	"github.com/AlecAivazis/survey/v2"
 
+	"gogen/internal/parser"
 	"gogen/pkg/models"
 )
 
-type MethodsPrompter struct{}
+type MethodsPrompter struct {
+	methodParser *parser.MethodParser
+}
 
 func NewMethodsPrompter() *MethodsPrompter {
-	return &MethodsPrompter{}
+	return &MethodsPrompter{
+		methodParser: parser.NewMethodParser(),
+	}
 }
 
 func (mp *MethodsPrompter) PromptMethods() ([]models.CustomMethod, error) {
 	fmt.Println("\nДобавление кастомных методов репозитория")
 	fmt.Println("Формат: MethodName(param1 Type, param2 Type) (ReturnType, error)")
 	fmt.Println("Пример: FindByEmail(email string) (*User, error)")
-	fmt.Println("Пустая строка для завершения\n")
+	fmt.Println("ctx context.Context добавляется автоматически")
+	fmt.Print("Пустая строка для завершения\n\n")
 
 	var methods []models.CustomMethod
 	i := 1
@@ -38,7 +44,7 @@ func (mp *MethodsPrompter) PromptMethods() ([]models.CustomMethod, error) {
 			break
 		}
 
-		method, err := mp.parseMethod(input)
+		method, err := mp.methodParser.Parse(input)
 		if err != nil {
 			fmt.Printf("  ⚠️  Ошибка: %v, попробуйте снова\n", err)
 			continue
@@ -50,22 +56,3 @@ func (mp *MethodsPrompter) PromptMethods() ([]models.CustomMethod, error) {
 
 	return methods, nil
 }
-
-func (mp *MethodsPrompter) parseMethod(input string) (models.CustomMethod, error) {
-
-	parenIdx := strings.Index(input, "(")
-	if parenIdx == -1 {
-		return models.CustomMethod{}, fmt.Errorf("неверный формат метода")
-	}
-
-	name := strings.TrimSpace(input[:parenIdx])
-
-	method := models.CustomMethod{
-		Name:    name,
-		Comment: fmt.Sprintf("%s кастомный метод репозитория", name),
-		Params:  []models.MethodParam{},
-		Returns: []string{"error"},
-	}
-
-	return method, nil
-}
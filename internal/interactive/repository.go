@@ -1 +1,102 @@
-package interactive
\ No newline at end of file
+package interactive
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"gogen/internal/dialect"
+	"gogen/pkg/models"
+)
+
+func (w *Wizard) promptRepositories(plan *models.GenerationPlan, cfg *models.Config) error {
+	options, err := w.entityOptions(plan, cfg)
+	if err != nil {
+		return err
+	}
+
+	if len(options) == 0 {
+		w.logger.Warn("Нет сущностей, для которых можно создать репозиторий")
+		return nil
+	}
+
+	var defaults []string
+	for _, entity := range plan.Entities {
+		defaults = append(defaults, entity.Name)
+	}
+
+	var selected []string
+	if err := w.ask(&survey.MultiSelect{
+		Message: "Для каких сущностей создать репозитории?",
+		Options: options,
+		Default: defaults,
+	}, &selected); err != nil {
+		return err
+	}
+
+	if len(selected) == 0 {
+		return nil
+	}
+
+	dbType := dialect.Postgres
+	if err := w.ask(&survey.Select{
+		Message: "Тип базы данных:",
+		Options: []string{dialect.Postgres, dialect.MySQL, dialect.SQLite, dialect.MongoDB},
+		Default: dbType,
+	}, &dbType); err != nil {
+		return err
+	}
+
+	withTransactions := true
+	if err := w.ask(&survey.Confirm{
+		Message: "Поддержка транзакций?",
+		Default: true,
+	}, &withTransactions); err != nil {
+		return err
+	}
+
+	for _, name := range selected {
+		if err := w.ensureEntity(plan, name, cfg); err != nil {
+			return err
+		}
+
+		repo := models.RepositoryConfig{
+			Name:             name,
+			Entity:           name,
+			TableName:        plan.GetEntityByName(name).TableName,
+			DBType:           dialect.Normalize(dbType),
+			WithTransactions: withTransactions,
+			AddComments:      true,
+		}
+
+		if err := promptCustomMethods(w.ask, &repo); err != nil {
+			return err
+		}
+
+		plan.Repositories = append(plan.Repositories, repo)
+	}
+
+	return nil
+}
+
+func promptCustomMethods(ask askFunc, repo *models.RepositoryConfig) error {
+	addCustom := false
+	if err := ask(&survey.Confirm{
+		Message: fmt.Sprintf("Добавить кастомные методы в %sRepository?", repo.Name),
+		Default: false,
+	}, &addCustom); err != nil {
+		return err
+	}
+
+	if !addCustom {
+		return nil
+	}
+
+	methods, err := NewMethodsPrompter().PromptMethods()
+	if err != nil {
+		return err
+	}
+	repo.CustomMethods = methods
+
+	return nil
+}
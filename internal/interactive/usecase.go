@@ -1 +1,126 @@
-package interactive
\ No newline at end of file
+package interactive
+
+import (
+	"fmt"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"gogen/pkg/models"
+)
+
+func (w *Wizard) promptUseCases(plan *models.GenerationPlan) error {
+	for {
+		name, err := w.askName("Имя use case, например CreateUser (пустая строка для завершения):", "UseCase")
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			return nil
+		}
+
+		if plan.GetUseCaseByName(name) != nil {
+			w.logger.Warn("Use case %s уже добавлен", name)
+			continue
+		}
+
+		uc := models.UseCaseConfig{
+			Name:         name,
+			Description:  fmt.Sprintf("операцию %s", name),
+			Dependencies: []models.Dependency{},
+			InputFields:  []models.Field{},
+			OutputFields: []models.Field{},
+			AddComments:  true,
+		}
+
+		if len(plan.Repositories) > 0 {
+			var options []string
+			for _, repo := range plan.Repositories {
+				options = append(options, repo.Name+"Repository")
+			}
+
+			var deps []string
+			if err := w.ask(&survey.MultiSelect{
+				Message: "Зависимости use case:",
+				Options: options,
+			}, &deps); err != nil {
+				return err
+			}
+
+			for _, dep := range deps {
+				uc.Dependencies = append(uc.Dependencies, models.Dependency{
+					Name:  dep,
+					Type:  "repository",
+					Found: true,
+				})
+			}
+		}
+
+		if err := promptUseCaseFields(w.ask, &uc); err != nil {
+			return err
+		}
+
+		if err := promptUseCaseFlags(w.ask, &uc); err != nil {
+			return err
+		}
+
+		plan.UseCases = append(plan.UseCases, uc)
+		w.logger.Success("Use case %s добавлен", name)
+	}
+}
+
+func promptUseCaseFields(ask askFunc, uc *models.UseCaseConfig) error {
+	addInput := false
+	if err := ask(&survey.Confirm{
+		Message: "Определить входные параметры (Input)?",
+		Default: len(uc.InputFields) == 0,
+	}, &addInput); err != nil {
+		return err
+	}
+
+	if addInput {
+		fields, err := NewFieldsPrompter().PromptFields()
+		if err != nil {
+			return err
+		}
+		uc.InputFields = fields
+	}
+
+	addOutput := false
+	if err := ask(&survey.Confirm{
+		Message: "Определить выходные параметры (Output)?",
+		Default: len(uc.OutputFields) == 0,
+	}, &addOutput); err != nil {
+		return err
+	}
+
+	if addOutput {
+		fields, err := NewFieldsPrompter().PromptFields()
+		if err != nil {
+			return err
+		}
+		uc.OutputFields = fields
+	}
+
+	return nil
+}
+
+func promptUseCaseFlags(ask askFunc, uc *models.UseCaseConfig) error {
+	if err := ask(&survey.Confirm{
+		Message: "Добавить логирование?",
+		Default: false,
+	}, &uc.WithLogging); err != nil {
+		return err
+	}
+
+	if err := ask(&survey.Confirm{
+		Message: "Добавить метрики?",
+		Default: false,
+	}, &uc.WithMetrics); err != nil {
+		return err
+	}
+
+	return ask(&survey.Confirm{
+		Message: "Выполнять в транзакции?",
+		Default: false,
+	}, &uc.Transactional)
+}
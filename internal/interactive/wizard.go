@@ -0,0 +1,249 @@
+package interactive
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"gogen/internal/logger"
+	"gogen/internal/manifest"
+	"gogen/internal/project"
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+const (
+	kindEntities     = "Сущности"
+	kindRepositories = "Репозитории"
+	kindUseCases     = "Use cases"
+	kindHandlers     = "HTTP handlers"
+)
+
+type askFunc func(prompt survey.Prompt, response interface{}, opts ...survey.AskOpt) error
+
+type Wizard struct {
+	logger     *logger.Logger
+	analyzer   *project.Analyzer
+	manifest   *manifest.Manifest
+	interactor *Interactor
+	ask        askFunc
+}
+
+func NewWizard(logger *logger.Logger, analyzer *project.Analyzer, m *manifest.Manifest) *Wizard {
+	return &Wizard{
+		logger:     logger,
+		analyzer:   analyzer,
+		manifest:   m,
+		interactor: NewInteractor(logger),
+		ask:        survey.AskOne,
+	}
+}
+
+func (w *Wizard) Run(cfg *models.Config) (*models.GenerationPlan, error) {
+	w.logger.Section("🧙 Мастер генерации компонентов")
+
+	var kinds []string
+	if err := w.ask(&survey.MultiSelect{
+		Message: "Какие компоненты создать?",
+		Options: []string{kindEntities, kindRepositories, kindUseCases, kindHandlers},
+		Default: []string{kindEntities, kindRepositories},
+	}, &kinds, survey.WithValidator(survey.Required)); err != nil {
+		return nil, err
+	}
+
+	plan := &models.GenerationPlan{}
+
+	if contains(kinds, kindEntities) {
+		if err := w.promptEntities(plan); err != nil {
+			return nil, err
+		}
+	}
+
+	if contains(kinds, kindRepositories) {
+		if err := w.promptRepositories(plan, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if contains(kinds, kindUseCases) {
+		if err := w.promptUseCases(plan); err != nil {
+			return nil, err
+		}
+	}
+
+	if contains(kinds, kindHandlers) {
+		if err := w.promptHandlers(plan, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if plan.IsEmpty() {
+		return nil, fmt.Errorf("не выбрано ни одного компонента")
+	}
+
+	if err := w.ask(&survey.Confirm{
+		Message: "Генерировать тесты?",
+		Default: false,
+	}, &plan.WithTests); err != nil {
+		return nil, err
+	}
+
+	if len(plan.Repositories) > 0 {
+		if err := w.ask(&survey.Confirm{
+			Message: "Генерировать моки репозиториев?",
+			Default: false,
+		}, &plan.WithMocks); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+func (w *Wizard) Confirm(plan *models.GenerationPlan) error {
+	return w.interactor.confirmGeneration(plan)
+}
+
+func (w *Wizard) promptHandlers(plan *models.GenerationPlan, cfg *models.Config) error {
+	if len(plan.UseCases) == 0 {
+		w.logger.Warn("Handlers строятся по use cases, а ни одного use case не выбрано")
+		return nil
+	}
+
+	options, err := w.entityOptions(plan, cfg)
+	if err != nil {
+		return err
+	}
+
+	var selected []string
+	if err := w.ask(&survey.MultiSelect{
+		Message: "Для каких сущностей создать HTTP handlers?",
+		Options: options,
+	}, &selected); err != nil {
+		return err
+	}
+
+	for _, name := range selected {
+		plan.Handlers = append(plan.Handlers, models.HandlerConfig{
+			Name:        name,
+			Route:       util.ToRoute(util.Pluralize(name)),
+			AddComments: true,
+		})
+	}
+
+	return nil
+}
+
+func (w *Wizard) entityOptions(plan *models.GenerationPlan, cfg *models.Config) ([]string, error) {
+	var options []string
+	for _, entity := range plan.Entities {
+		options = append(options, entity.Name)
+	}
+
+	existing, err := w.analyzer.FindExistingEntities(cfg.Paths.Domain)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось найти существующие сущности: %w", err)
+	}
+
+	for _, name := range existing {
+		if !contains(options, name) {
+			options = append(options, name)
+		}
+	}
+
+	return options, nil
+}
+
+func (w *Wizard) ensureEntity(plan *models.GenerationPlan, name string, cfg *models.Config) error {
+	if plan.HasEntity(name) {
+		return nil
+	}
+
+	path := filepath.Join(cfg.Paths.Domain, util.ToSnakeCase(name)+".go")
+
+	entity, ok := w.manifest.Entity(name)
+	if !ok {
+		fields, err := w.analyzer.ExtractStructFields(path, name)
+		if err != nil {
+			return fmt.Errorf("не удалось прочитать сущность %s: %w", name, err)
+		}
+
+		if entity, err = existingEntity(name, fields); err != nil {
+			return err
+		}
+	}
+
+	entity.Reused = true
+	plan.Entities = append(plan.Entities, entity)
+	w.logger.Info("Используется существующая сущность %s (%s)", name, path)
+
+	return nil
+}
+
+// existingEntity restores the configuration of a hand-written entity from its
+// struct: the tracking columns it declares select the matching options.
+func existingEntity(name string, fields []models.Field) (models.EntityConfig, error) {
+	entity := newEntity(name)
+
+	id := -1
+	for i, field := range fields {
+		if field.DBTag == "id" {
+			id = i
+		}
+	}
+	if id < 0 {
+		return models.EntityConfig{}, fmt.Errorf("сущность %s не содержит поля ID", name)
+	}
+
+	switch fields[id].Type {
+	case "int", "int64":
+		entity.IDStrategy = models.IDInt64
+	case "string":
+		entity.IDStrategy = models.IDString
+	default:
+		entity.IDStrategy = models.IDUUID
+	}
+
+	entity.NoTimestamps = !models.HasColumn(fields, "created_at") || !models.HasColumn(fields, "updated_at")
+	entity.SoftDelete = models.HasColumn(fields, "deleted_at")
+	entity.Versioned = models.HasColumn(fields, "version")
+	entity.Audit = models.HasColumn(fields, "created_by") && models.HasColumn(fields, "updated_by")
+
+	implicit := entity.ImplicitFields()
+	for _, field := range fields {
+		if !models.HasColumn(implicit, field.DBTag) {
+			entity.Fields = append(entity.Fields, field)
+		}
+	}
+
+	return entity, nil
+}
+
+func (w *Wizard) askName(message, suffix string) (string, error) {
+	name := ""
+	err := w.ask(&survey.Input{
+		Message: message,
+	}, &name, survey.WithValidator(func(ans interface{}) error {
+		value := strings.TrimSpace(ans.(string))
+		if value == "" {
+			return nil
+		}
+		return util.ValidatePascalCase(strings.TrimSuffix(value, suffix))
+	}))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(strings.TrimSpace(name), suffix), nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
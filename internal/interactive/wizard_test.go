@@ -0,0 +1,108 @@
+package interactive
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"gogen/internal/logger"
+	"gogen/internal/manifest"
+	"gogen/internal/project"
+	"gogen/pkg/models"
+)
+
+const userEntity = `package domain
+
+import "time"
+
+type User struct {
+	ID        string    ` + "`json:\"id\" db:\"id\"`" + `
+	Email     string    ` + "`json:\"email\" db:\"email\"`" + `
+	CreatedAt time.Time ` + "`json:\"created_at\" db:\"created_at\"`" + `
+	UpdatedAt time.Time ` + "`json:\"updated_at\" db:\"updated_at\"`" + `
+}
+`
+
+// scripted answers the wizard prompts in order.
+func scripted(t *testing.T, answers ...interface{}) askFunc {
+	return func(prompt survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+		if len(answers) == 0 {
+			t.Fatalf("unexpected prompt %T", prompt)
+		}
+		reflect.ValueOf(response).Elem().Set(reflect.ValueOf(answers[0]))
+		answers = answers[1:]
+		return nil
+	}
+}
+
+func TestPromptRepositoriesReusesEntity(t *testing.T) {
+	root := t.TempDir()
+	domain := filepath.Join(root, "internal", "domain")
+	if err := os.MkdirAll(domain, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "go.mod"), []byte("module example.com/app\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(domain, "user.go"), []byte(userEntity), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	recorded := newEntity("User")
+	recorded.Fields = []models.Field{{Name: "Email", Type: "string"}, {Name: "Name", Type: "string"}}
+
+	withEntity := manifest.New()
+	withEntity.Record(manifest.Entry{
+		Path:      "internal/domain/user.go",
+		Component: models.ComponentTypeEntity,
+		Name:      "User",
+		Entity:    &recorded,
+	})
+
+	tests := []struct {
+		name     string
+		manifest *manifest.Manifest
+		fields   []string
+	}{
+		{name: "from struct", manifest: manifest.New(), fields: []string{"Email"}},
+		{name: "from manifest", manifest: withEntity, fields: []string{"Email", "Name"}},
+	}
+
+	cfg := &models.Config{Paths: models.Paths{Domain: "internal/domain"}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := NewWizard(logger.NewLogger(logger.LevelError, false), project.NewAnalyzer(project.NewFinder(root)), tt.manifest)
+			w.ask = scripted(t, []string{"User"}, "mysql", false, false)
+
+			plan := &models.GenerationPlan{}
+			if err := w.promptRepositories(plan, cfg); err != nil {
+				t.Fatalf("promptRepositories() error = %v", err)
+			}
+
+			entity := plan.GetEntityByName("User")
+			if entity == nil || !entity.Reused {
+				t.Fatalf("entity User was not reused: %+v", plan.Entities)
+			}
+
+			var fields []string
+			for _, field := range entity.Fields {
+				fields = append(fields, field.Name)
+			}
+			if !reflect.DeepEqual(fields, tt.fields) {
+				t.Errorf("fields = %v, want %v", fields, tt.fields)
+			}
+
+			if len(plan.Repositories) != 1 {
+				t.Fatalf("repositories = %+v, want one", plan.Repositories)
+			}
+			repo := plan.Repositories[0]
+			if repo.DBType != "mysql" || repo.WithTransactions {
+				t.Errorf("repository = %+v, want mysql without transactions", repo)
+			}
+		})
+	}
+}
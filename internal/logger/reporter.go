@@ -43,6 +43,12 @@ func (r *Reporter) ReportStart(plan *models.GenerationPlan) {
 			r.joinUseCaseNames(plan.UseCases))
 	}
 
+	if len(plan.Handlers) > 0 {
+		fmt.Printf("  ✓ %d handlers: %s\n",
+			len(plan.Handlers),
+			r.joinHandlerNames(plan.Handlers))
+	}
+
 	if plan.WithTests {
 		fmt.Println("  ✓ Юнит-тесты для всех компонентов")
 	}
@@ -129,3 +135,11 @@ func (r *Reporter) joinUseCaseNames(usecases []models.UseCaseConfig) string {
 	}
 	return strings.Join(names, ", ")
 }
+
+func (r *Reporter) joinHandlerNames(handlers []models.HandlerConfig) string {
+	names := make([]string, len(handlers))
+	for i, h := range handlers {
+		names[i] = h.Name
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,167 @@
+package manifest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"gogen/pkg/models"
+)
+
+const (
+	Dir      = ".gogen"
+	FileName = "manifest.json"
+	Version  = "1"
+)
+
+type Manifest struct {
+	Version string  `json:"version"`
+	Files   []Entry `json:"files"`
+}
+
+type Entry struct {
+	Path         string               `json:"path"`
+	Component    models.ComponentType `json:"component"`
+	Name         string               `json:"name"`
+	Template     string               `json:"template,omitempty"`
+	TemplateHash string               `json:"template_hash,omitempty"`
+	OutputHash   string               `json:"output_hash"`
+	Regions      map[string]string    `json:"regions,omitempty"`
+	GeneratedAt  time.Time            `json:"generated_at"`
+	Entity       *models.EntityConfig `json:"entity,omitempty"`
+}
+
+type FileState string
+
+const (
+	StateUnchanged FileState = "unchanged"
+	StateModified  FileState = "modified"
+	StateMissing   FileState = "missing"
+)
+
+type FileStatus struct {
+	Entry Entry
+	State FileState
+}
+
+func Path(root string) string {
+	return filepath.Join(root, Dir, FileName)
+}
+
+func New() *Manifest {
+	return &Manifest{Version: Version}
+}
+
+func Load(root string) (*Manifest, error) {
+	data, err := os.ReadFile(Path(root))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return New(), nil
+		}
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	m := New()
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", Path(root), err)
+	}
+
+	return m, nil
+}
+
+func (m *Manifest) Save(root string) error {
+	sort.Slice(m.Files, func(i, j int) bool {
+		return m.Files[i].Path < m.Files[j].Path
+	})
+
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode manifest: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Join(root, Dir), 0755); err != nil {
+		return fmt.Errorf("failed to create manifest directory: %w", err)
+	}
+
+	if err := os.WriteFile(Path(root), append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	return nil
+}
+
+func (m *Manifest) Record(entry Entry) {
+	entry.Path = filepath.ToSlash(entry.Path)
+
+	for i := range m.Files {
+		if m.Files[i].Path == entry.Path {
+			m.Files[i] = entry
+			return
+		}
+	}
+
+	m.Files = append(m.Files, entry)
+}
+
+func (m *Manifest) Get(path string) (Entry, bool) {
+	path = filepath.ToSlash(path)
+
+	for _, entry := range m.Files {
+		if entry.Path == path {
+			return entry, true
+		}
+	}
+
+	return Entry{}, false
+}
+
+func (m *Manifest) Entity(name string) (models.EntityConfig, bool) {
+	for _, entry := range m.Files {
+		if entry.Component == models.ComponentTypeEntity && entry.Name == name && entry.Entity != nil {
+			return *entry.Entity, true
+		}
+	}
+
+	return models.EntityConfig{}, false
+}
+
+func (m *Manifest) Remove(path string) {
+	path = filepath.ToSlash(path)
+
+	for i := range m.Files {
+		if m.Files[i].Path == path {
+			m.Files = append(m.Files[:i], m.Files[i+1:]...)
+			return
+		}
+	}
+}
+
+func (m *Manifest) Status(root string) ([]FileStatus, error) {
+	statuses := make([]FileStatus, 0, len(m.Files))
+
+	for _, entry := range m.Files {
+		data, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(entry.Path)))
+		switch {
+		case os.IsNotExist(err):
+			statuses = append(statuses, FileStatus{Entry: entry, State: StateMissing})
+		case err != nil:
+			return nil, fmt.Errorf("failed to read %s: %w", entry.Path, err)
+		case Hash(data) != entry.OutputHash:
+			statuses = append(statuses, FileStatus{Entry: entry, State: StateModified})
+		default:
+			statuses = append(statuses, FileStatus{Entry: entry, State: StateUnchanged})
+		}
+	}
+
+	return statuses, nil
+}
+
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
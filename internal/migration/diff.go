@@ -0,0 +1,269 @@
+package migration
+
+import (
+	"fmt"
+	"strings"
+
+	"gogen/internal/dialect"
+	"gogen/pkg/models"
+)
+
+type ColumnChange struct {
+	Old models.Field
+	New models.Field
+}
+
+type TableDiff struct {
+	Added   []models.Field
+	Removed []models.Field
+	Changed []ColumnChange
+}
+
+func (d TableDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+func AlterTableName(table string) string {
+	return "alter_" + table
+}
+
+// EntityDiff compares an existing entity with the version it was last generated from: the
+// manifest snapshot when there is one, otherwise the fields parsed back from its domain struct.
+func EntityDiff(entity *models.EntityConfig) TableDiff {
+	if entity.Previous != nil {
+		return Diff(entity.Previous.Schema().Columns, entity.Schema().Columns, entity.PrimaryKey(), true)
+	}
+	return Diff(entity.PreviousFields, entity.Schema().Columns, entity.PrimaryKey(), false)
+}
+
+// Diff compares the columns of two versions of a table, leaving the primary key alone. Without
+// constraints only the types of previous are trusted: a parsed domain struct does not record
+// required, unique or index, so those are taken from current.
+func Diff(previous, current []models.Field, key models.PrimaryKey, constraints bool) TableDiff {
+	var diff TableDiff
+
+	old := make(map[string]models.Field, len(previous))
+	for _, field := range previous {
+		if !key.Contains(field) {
+			old[field.DBTag] = field
+		}
+	}
+
+	seen := make(map[string]bool, len(current))
+	for _, field := range current {
+		seen[field.DBTag] = true
+		if key.Contains(field) {
+			continue
+		}
+
+		prev, ok := old[field.DBTag]
+		if !ok {
+			diff.Added = append(diff.Added, field)
+			continue
+		}
+
+		if !constraints {
+			prev.Required, prev.Unique, prev.Index = field.Required, field.Unique, field.Index
+		}
+		if prev.Type != field.Type || notNull(prev) != notNull(field) ||
+			prev.Unique != field.Unique || indexed(prev) != indexed(field) {
+			diff.Changed = append(diff.Changed, ColumnChange{Old: prev, New: field})
+		}
+	}
+
+	for _, field := range previous {
+		if !key.Contains(field) && !seen[field.DBTag] {
+			diff.Removed = append(diff.Removed, field)
+		}
+	}
+
+	return diff
+}
+
+func AlterTable(d dialect.Dialect, version, table string, diff TableDiff) Migration {
+	var up []string
+	var down [][]string
+
+	for _, field := range diff.Added {
+		up = append(up, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", table, addColumnDefinition(d, field)))
+
+		var revert []string
+		if indexed(field) {
+			up = append(up, createIndex(d, table, field.DBTag))
+			revert = append(revert, dropIndex(d, table, field.DBTag))
+		}
+		down = append(down, append(revert, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", table, field.DBTag)))
+	}
+
+	for _, change := range diff.Changed {
+		// A Go type change can map to the same column type, which leaves nothing to alter.
+		if statements := alterColumn(d, table, change.Old, change.New); len(statements) > 0 {
+			up = append(up, statements...)
+			down = append(down, alterColumn(d, table, change.New, change.Old))
+		}
+	}
+
+	for _, field := range diff.Removed {
+		up = append(up, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", table, field.DBTag))
+		down = append(down, []string{fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", table, addColumnDefinition(d, field))})
+	}
+
+	if len(up) == 0 {
+		return Migration{Version: version, Name: AlterTableName(table)}
+	}
+
+	var reverted []string
+	for i := len(down) - 1; i >= 0; i-- {
+		reverted = append(reverted, down[i]...)
+	}
+
+	return Migration{
+		Version: version,
+		Name:    AlterTableName(table),
+		Up:      strings.Join(up, "\n") + "\n",
+		Down:    strings.Join(reverted, "\n") + "\n",
+	}
+}
+
+func addColumnDefinition(d dialect.Dialect, field models.Field) string {
+	definition := columnDefinition(d, field)
+
+	if notNull(field) {
+		goType, _ := baseType(field.Type)
+		value := defaultValue(goType)
+		if field.IsEnum() {
+			value = "'" + field.Enum[0] + "'"
+		}
+		if value != "" {
+			definition += " DEFAULT " + value
+		}
+	}
+
+	return definition
+}
+
+func alterColumn(d dialect.Dialect, table string, from, to models.Field) []string {
+	var statements []string
+
+	if columnType(d, from) != columnType(d, to) || notNull(from) != notNull(to) {
+		statements = append(statements, modifyColumn(d, table, from, to)...)
+	}
+
+	switch {
+	case to.Unique && !from.Unique:
+		statements = append(statements, addUnique(d, table, to.DBTag))
+	case from.Unique && !to.Unique:
+		statements = append(statements, dropUnique(d, table, to.DBTag))
+	}
+
+	switch {
+	case indexed(to) && !indexed(from):
+		statements = append(statements, createIndex(d, table, to.DBTag))
+	case indexed(from) && !indexed(to):
+		statements = append(statements, dropIndex(d, table, to.DBTag))
+	}
+
+	return statements
+}
+
+func modifyColumn(d dialect.Dialect, table string, from, to models.Field) []string {
+	switch d.Name() {
+	case dialect.Postgres:
+		var statements []string
+		if sqlType := columnType(d, to); sqlType != columnType(d, from) {
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s TYPE %s USING %s::%s;",
+				table, to.DBTag, sqlType, to.DBTag, sqlType))
+		}
+		if notNull(from) != notNull(to) {
+			nullability := "DROP NOT NULL"
+			if notNull(to) {
+				nullability = "SET NOT NULL"
+			}
+			statements = append(statements, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s %s;", table, to.DBTag, nullability))
+		}
+		return statements
+	case dialect.MySQL:
+		// MODIFY COLUMN would add a second unique index; uniqueness is altered separately.
+		definition := to
+		definition.Unique = false
+		return []string{fmt.Sprintf("ALTER TABLE %s MODIFY COLUMN %s;", table, columnDefinition(d, definition))}
+	default:
+		return []string{fmt.Sprintf("-- %s cannot alter %s.%s (%s -> %s); rebuild the table manually.",
+			d.Name(), table, to.DBTag, columnSummary(d, from), columnSummary(d, to))}
+	}
+}
+
+// addUnique and dropUnique name the constraint the way each database names an inline
+// UNIQUE column from CreateTable, so either can be dropped later.
+func addUnique(d dialect.Dialect, table, column string) string {
+	switch d.Name() {
+	case dialect.Postgres:
+		return fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s_%s_key UNIQUE (%s);", table, table, column, column)
+	case dialect.MySQL:
+		return fmt.Sprintf("ALTER TABLE %s ADD UNIQUE INDEX %s (%s);", table, column, column)
+	default:
+		return fmt.Sprintf("CREATE UNIQUE INDEX IF NOT EXISTS %s ON %s (%s);", uniqueIndexName(table, column), table, column)
+	}
+}
+
+func dropUnique(d dialect.Dialect, table, column string) string {
+	switch d.Name() {
+	case dialect.Postgres:
+		return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s_%s_key;", table, table, column)
+	case dialect.MySQL:
+		return fmt.Sprintf("ALTER TABLE %s DROP INDEX %s;", table, column)
+	default:
+		return fmt.Sprintf("DROP INDEX IF EXISTS %s; -- an inline UNIQUE from CREATE TABLE needs a table rebuild.",
+			uniqueIndexName(table, column))
+	}
+}
+
+func columnSummary(d dialect.Dialect, field models.Field) string {
+	summary := columnType(d, field)
+	if notNull(field) {
+		summary += " NOT NULL"
+	}
+	return summary
+}
+
+// indexed reports whether the column has its own non-unique index; a unique column is
+// already indexed by its constraint.
+func indexed(field models.Field) bool {
+	return field.Index && !field.Unique
+}
+
+func createIndex(d dialect.Dialect, table, column string) string {
+	if d.Name() == dialect.MySQL {
+		return fmt.Sprintf("CREATE INDEX %s ON %s (%s);", indexName(table, column), table, column)
+	}
+	return fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s);", indexName(table, column), table, column)
+}
+
+func dropIndex(d dialect.Dialect, table, column string) string {
+	if d.Name() == dialect.MySQL {
+		return fmt.Sprintf("DROP INDEX %s ON %s;", indexName(table, column), table)
+	}
+	return fmt.Sprintf("DROP INDEX IF EXISTS %s;", indexName(table, column))
+}
+
+func indexName(table, column string) string {
+	return fmt.Sprintf("idx_%s_%s", table, column)
+}
+
+func uniqueIndexName(table, column string) string {
+	return fmt.Sprintf("uq_%s_%s", table, column)
+}
+
+func defaultValue(goType string) string {
+	switch goType {
+	case "string":
+		return "''"
+	case "bool":
+		return "FALSE"
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "float32", "float64":
+		return "0"
+	default:
+		return ""
+	}
+}
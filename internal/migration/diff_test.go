@@ -0,0 +1,144 @@
+package migration
+
+import (
+	"strings"
+	"testing"
+
+	"gogen/internal/dialect"
+	"gogen/pkg/models"
+)
+
+func TestDiff(t *testing.T) {
+	id := models.Field{Name: "ID", Type: "int64", DBTag: "id"}
+	key := models.PrimaryKey{Fields: []models.Field{id}}
+	title := models.Field{Name: "Title", Type: "string", DBTag: "title"}
+	code := models.Field{Name: "Code", Type: "string", DBTag: "code", Index: true}
+	deletedAt := models.Field{Name: "DeletedAt", Type: "*time.Time", DBTag: "deleted_at"}
+
+	uniqueTitle := title
+	uniqueTitle.Unique = true
+	plainCode := code
+	plainCode.Index = false
+	wideTitle := title
+	wideTitle.Type = "[]byte"
+
+	tests := []struct {
+		name        string
+		previous    []models.Field
+		current     []models.Field
+		constraints bool
+		added       []string
+		removed     []string
+		changed     []string
+	}{
+		{
+			name:     "added and removed columns",
+			previous: []models.Field{id, title, code},
+			current:  []models.Field{id, title, deletedAt},
+			added:    []string{"deleted_at"},
+			removed:  []string{"code"},
+		},
+		{
+			name:     "primary key is left alone",
+			previous: []models.Field{id, title},
+			current:  []models.Field{title},
+		},
+		{
+			name:     "type change",
+			previous: []models.Field{id, title},
+			current:  []models.Field{id, wideTitle},
+			changed:  []string{"title"},
+		},
+		{
+			name:        "constraint changes",
+			previous:    []models.Field{id, title, code},
+			current:     []models.Field{id, uniqueTitle, plainCode},
+			constraints: true,
+			changed:     []string{"title", "code"},
+		},
+		{
+			name:     "constraints are not trusted without a snapshot",
+			previous: []models.Field{id, title, code},
+			current:  []models.Field{id, uniqueTitle, plainCode},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diff := Diff(tt.previous, tt.current, key, tt.constraints)
+
+			if got := columnNames(diff.Added); got != strings.Join(tt.added, ",") {
+				t.Errorf("Added = %q, want %v", got, tt.added)
+			}
+			if got := columnNames(diff.Removed); got != strings.Join(tt.removed, ",") {
+				t.Errorf("Removed = %q, want %v", got, tt.removed)
+			}
+
+			var changed []models.Field
+			for _, change := range diff.Changed {
+				changed = append(changed, change.New)
+			}
+			if got := columnNames(changed); got != strings.Join(tt.changed, ",") {
+				t.Errorf("Changed = %q, want %v", got, tt.changed)
+			}
+		})
+	}
+}
+
+func TestAlterTable(t *testing.T) {
+	title := models.Field{Name: "Title", Type: "string", DBTag: "title"}
+	uniqueTitle := title
+	uniqueTitle.Unique = true
+	count := models.Field{Name: "Count", Type: "int", DBTag: "count", Index: true}
+	wideCount := count
+	wideCount.Type = "int64"
+
+	tests := []struct {
+		dialect string
+		diff    TableDiff
+		up      []string
+		down    []string
+	}{
+		{
+			dialect: dialect.Postgres,
+			diff:    TableDiff{Changed: []ColumnChange{{Old: title, New: uniqueTitle}}},
+			up:      []string{"ALTER TABLE items ADD CONSTRAINT items_title_key UNIQUE (title);"},
+			down:    []string{"ALTER TABLE items DROP CONSTRAINT IF EXISTS items_title_key;"},
+		},
+		{
+			dialect: dialect.MySQL,
+			diff:    TableDiff{Changed: []ColumnChange{{Old: uniqueTitle, New: title}}},
+			up:      []string{"ALTER TABLE items DROP INDEX title;"},
+			down:    []string{"ALTER TABLE items ADD UNIQUE INDEX title (title);"},
+		},
+		{
+			dialect: dialect.SQLite,
+			diff:    TableDiff{Changed: []ColumnChange{{Old: count, New: wideCount}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dialect, func(t *testing.T) {
+			d, err := dialect.Get(tt.dialect)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m := AlterTable(d, "20240101000000", "items", tt.diff)
+			if got, want := strings.TrimSpace(m.Up), strings.Join(tt.up, "\n"); got != want {
+				t.Errorf("Up = %q, want %q", got, want)
+			}
+			if got, want := strings.TrimSpace(m.Down), strings.Join(tt.down, "\n"); got != want {
+				t.Errorf("Down = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func columnNames(fields []models.Field) string {
+	var names []string
+	for _, field := range fields {
+		names = append(names, field.DBTag)
+	}
+	return strings.Join(names, ",")
+}
@@ -0,0 +1,229 @@
+package migration
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gogen/internal/dialect"
+	"gogen/pkg/models"
+)
+
+const (
+	FormatGolangMigrate = "golang-migrate"
+	FormatGoose         = "goose"
+	FormatNone          = "none"
+
+	versionLayout = "20060102150405"
+)
+
+type Migration struct {
+	Version string
+	Name    string
+	Up      string
+	Down    string
+}
+
+type File struct {
+	Name    string
+	Content string
+}
+
+func Version(t time.Time) string {
+	return t.UTC().Format(versionLayout)
+}
+
+func ParseVersion(version string) (time.Time, error) {
+	return time.Parse(versionLayout, version)
+}
+
+func LatestVersion(paths []string) string {
+	var latest string
+
+	for _, path := range paths {
+		version, _, ok := strings.Cut(filepath.Base(path), "_")
+		if !ok || len(version) != len(versionLayout) {
+			continue
+		}
+		if version > latest {
+			latest = version
+		}
+	}
+
+	return latest
+}
+
+func CreateTableName(table string) string {
+	return "create_" + table
+}
+
+func CreateTable(d dialect.Dialect, version, table string, key models.PrimaryKey, fields []models.Field, uniqueKeys [][]models.Field, relations []models.Relation) Migration {
+	var definitions []string
+	switch {
+	case key.AutoIncrement():
+		definitions = append(definitions, d.IdentityColumn("id"))
+	case !key.Composite():
+		definitions = append(definitions, fmt.Sprintf("id %s PRIMARY KEY", d.ColumnType(key.Type())))
+	}
+	for _, field := range fields {
+		definitions = append(definitions, columnDefinition(d, field))
+	}
+	if key.Composite() {
+		definitions = append(definitions, fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(key.Columns(), ", ")))
+	}
+	for _, group := range uniqueKeys {
+		definitions = append(definitions, fmt.Sprintf("UNIQUE (%s)", strings.Join(models.PrimaryKey{Fields: group}.Columns(), ", ")))
+	}
+
+	for _, rel := range relations {
+		if rel.Kind == models.RelationBelongsTo {
+			definitions = append(definitions, foreignKey(rel.ForeignKey, rel.Table))
+		}
+	}
+
+	var indexes []string
+	for _, field := range fields {
+		if !field.Index || field.Unique {
+			continue
+		}
+
+		name := indexName(table, field.DBTag)
+		if d.Name() == dialect.MySQL {
+			definitions = append(definitions, fmt.Sprintf("INDEX %s (%s)", name, field.DBTag))
+			continue
+		}
+		indexes = append(indexes,
+			fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s);", name, table, field.DBTag))
+	}
+
+	var up strings.Builder
+	fmt.Fprintf(&up, "CREATE TABLE IF NOT EXISTS %s (\n    %s\n);\n",
+		table, strings.Join(definitions, ",\n    "))
+	if len(indexes) > 0 {
+		fmt.Fprintf(&up, "\n%s\n", strings.Join(indexes, "\n"))
+	}
+
+	return Migration{
+		Version: version,
+		Name:    CreateTableName(table),
+		Up:      up.String(),
+		Down:    fmt.Sprintf("DROP TABLE IF EXISTS %s;\n", table),
+	}
+}
+
+func CreateJoinTable(d dialect.Dialect, version, table string, rel models.Relation) Migration {
+	definitions := []string{
+		fmt.Sprintf("%s %s NOT NULL", rel.ForeignKey, d.ColumnType(rel.KeyType)),
+		fmt.Sprintf("%s %s NOT NULL", rel.References, d.ColumnType(rel.ReferenceType)),
+		fmt.Sprintf("PRIMARY KEY (%s, %s)", rel.ForeignKey, rel.References),
+		foreignKey(rel.ForeignKey, table) + " ON DELETE CASCADE",
+		foreignKey(rel.References, rel.Table) + " ON DELETE CASCADE",
+	}
+
+	return Migration{
+		Version: version,
+		Name:    CreateTableName(rel.JoinTable),
+		Up: fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (\n    %s\n);\n",
+			rel.JoinTable, strings.Join(definitions, ",\n    ")),
+		Down: fmt.Sprintf("DROP TABLE IF EXISTS %s;\n", rel.JoinTable),
+	}
+}
+
+func (m Migration) Files(format string) ([]File, error) {
+	base := m.Version + "_" + m.Name
+
+	switch format {
+	case "", FormatGolangMigrate:
+		return []File{
+			{Name: base + ".up.sql", Content: m.Up},
+			{Name: base + ".down.sql", Content: m.Down},
+		}, nil
+	case FormatGoose:
+		content := "-- +goose Up\n" + m.Up + "\n-- +goose Down\n" + m.Down
+		return []File{{Name: base + ".sql", Content: content}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported migration format: %s", format)
+	}
+}
+
+func columnDefinition(d dialect.Dialect, field models.Field) string {
+	definition := fmt.Sprintf("%s %s", field.DBTag, columnType(d, field))
+	if notNull(field) {
+		definition += " NOT NULL"
+	}
+	if field.Unique {
+		definition += " UNIQUE"
+	}
+	if field.IsEnum() && d.Name() != dialect.MySQL {
+		definition += fmt.Sprintf(" CHECK (%s IN (%s))", field.DBTag, enumValues(field.Enum))
+	}
+
+	return definition
+}
+
+func columnType(d dialect.Dialect, field models.Field) string {
+	if field.IsEnum() && d.Name() == dialect.MySQL {
+		return fmt.Sprintf("ENUM(%s)", enumValues(field.Enum))
+	}
+
+	goType, _ := baseType(field.Type)
+	return d.ColumnType(goType)
+}
+
+// notNull reports whether the column rejects NULL. Optional enums store their
+// zero value as NULL, so only required ones are NOT NULL.
+func notNull(field models.Field) bool {
+	_, nullable := baseType(field.Type)
+	if field.IsEnum() && !field.Required {
+		nullable = true
+	}
+	return !nullable || field.Required
+}
+
+func enumValues(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = "'" + value + "'"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func foreignKey(column, table string) string {
+	return fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (id)", column, table)
+}
+
+func baseType(goType string) (string, bool) {
+	if strings.HasPrefix(goType, "*") {
+		return strings.TrimPrefix(goType, "*"), true
+	}
+
+	switch goType {
+	case "sql.NullString":
+		return "string", true
+	case "sql.NullInt64":
+		return "int64", true
+	case "sql.NullInt32":
+		return "int32", true
+	case "sql.NullInt16":
+		return "int16", true
+	case "sql.NullByte":
+		return "uint8", true
+	case "sql.NullBool":
+		return "bool", true
+	case "sql.NullFloat64":
+		return "float64", true
+	case "sql.NullTime":
+		return "time.Time", true
+	case "uuid.NullUUID":
+		return "uuid.UUID", true
+	case "json.RawMessage":
+		return goType, true
+	}
+
+	if strings.HasPrefix(goType, "[]") || strings.HasPrefix(goType, "map[") {
+		return goType, true
+	}
+
+	return goType, false
+}
@@ -0,0 +1,772 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"gogen/internal/dialect"
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+const (
+	NullPointer = "pointer"
+	NullSQL     = "sql"
+)
+
+type DDLParser struct {
+	dialect   string
+	nullStyle string
+}
+
+func NewDDLParser(dbType, nullStyle string) *DDLParser {
+	if nullStyle == "" {
+		nullStyle = NullPointer
+	}
+
+	return &DDLParser{
+		dialect:   dialect.Normalize(dbType),
+		nullStyle: nullStyle,
+	}
+}
+
+type TableSchema struct {
+	Name       string
+	Columns    []ColumnSchema
+	UniqueKeys [][]string
+}
+
+type ColumnSchema struct {
+	Name       string
+	Type       string
+	GoType     string
+	NotNull    bool
+	PrimaryKey bool
+	Unique     bool
+	Index      bool
+	HasDefault bool
+}
+
+func (t *TableSchema) column(name string) *ColumnSchema {
+	for i := range t.Columns {
+		if strings.EqualFold(t.Columns[i].Name, name) {
+			return &t.Columns[i]
+		}
+	}
+	return nil
+}
+
+func (p *DDLParser) ParseEntities(input string) ([]models.EntityConfig, error) {
+	tables, err := p.Parse(input)
+	if err != nil {
+		return nil, err
+	}
+
+	entities := make([]models.EntityConfig, 0, len(tables))
+	for _, table := range tables {
+		entity, err := p.buildEntity(table)
+		if err != nil {
+			return nil, fmt.Errorf("table %s: %w", table.Name, err)
+		}
+		entities = append(entities, entity)
+	}
+
+	return entities, nil
+}
+
+func (p *DDLParser) Parse(input string) ([]TableSchema, error) {
+	tokens, err := tokenizeSQL(input)
+	if err != nil {
+		return nil, err
+	}
+
+	var tables []TableSchema
+
+	for _, stmt := range splitStatements(tokens) {
+		if len(stmt) < 2 || !stmt[0].is("CREATE") {
+			continue
+		}
+
+		rest := skipWords(stmt[1:], "OR", "REPLACE", "TEMP", "TEMPORARY", "UNLOGGED")
+		if len(rest) == 0 {
+			continue
+		}
+
+		switch {
+		case rest[0].is("TABLE"):
+			table, err := p.parseCreateTable(rest[1:])
+			if err != nil {
+				return nil, err
+			}
+			tables = append(tables, table)
+		case rest[0].is("INDEX"):
+			if err := applyCreateIndex(tables, rest[1:], false); err != nil {
+				return nil, err
+			}
+		case rest[0].is("UNIQUE") && len(rest) > 1 && rest[1].is("INDEX"):
+			if err := applyCreateIndex(tables, rest[2:], true); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(tables) == 0 {
+		return nil, fmt.Errorf("no CREATE TABLE statements found")
+	}
+
+	return tables, nil
+}
+
+func (p *DDLParser) parseCreateTable(tokens []sqlToken) (TableSchema, error) {
+	tokens = skipWords(tokens, "IF", "NOT", "EXISTS")
+
+	name, tokens := parseQualifiedName(tokens)
+	if name == "" {
+		return TableSchema{}, fmt.Errorf("CREATE TABLE without table name")
+	}
+
+	body, _, ok := parenGroup(tokens)
+	if !ok {
+		return TableSchema{}, fmt.Errorf("table %s: expected column definitions", name)
+	}
+
+	table := TableSchema{Name: name}
+
+	for _, def := range splitTopLevel(body) {
+		if len(def) == 0 {
+			continue
+		}
+
+		if isTableConstraint(def) {
+			applyTableConstraint(&table, def)
+			continue
+		}
+
+		column, err := p.parseColumn(def)
+		if err != nil {
+			return TableSchema{}, fmt.Errorf("table %s: %w", name, err)
+		}
+		table.Columns = append(table.Columns, column)
+	}
+
+	if len(table.Columns) == 0 {
+		return TableSchema{}, fmt.Errorf("table %s has no columns", name)
+	}
+
+	return table, nil
+}
+
+func (p *DDLParser) parseColumn(def []sqlToken) (ColumnSchema, error) {
+	column := ColumnSchema{Name: def[0].text}
+
+	var typeWords []string
+	var typeParams string
+	var isArray, isUnsigned bool
+
+	i := 1
+	for i < len(def) {
+		tok := def[i]
+
+		if tok.kind == tokenWord && isColumnConstraintKeyword(tok.upper()) {
+			break
+		}
+		if tok.is("CHARACTER") && i+1 < len(def) && def[i+1].is("SET") && len(typeWords) > 0 {
+			break
+		}
+
+		switch {
+		case tok.is("UNSIGNED"):
+			isUnsigned = true
+		case tok.is("ZEROFILL"):
+		case tok.is("ARRAY"):
+			isArray = true
+		case tok.text == "[":
+			isArray = true
+			for i < len(def) && def[i].text != "]" {
+				i++
+			}
+		case tok.text == "(":
+			group, rest, _ := parenGroup(def[i:])
+			if typeParams == "" {
+				typeParams = joinTokens(group)
+			}
+			i = len(def) - len(rest)
+			continue
+		default:
+			typeWords = append(typeWords, strings.ToLower(tok.text))
+		}
+		i++
+	}
+
+	column.Type = strings.Join(typeWords, " ")
+	if typeParams != "" {
+		column.Type += "(" + typeParams + ")"
+	}
+
+	for ; i < len(def); i++ {
+		tok := def[i]
+
+		switch {
+		case tok.is("NOT") && i+1 < len(def) && def[i+1].is("NULL"):
+			column.NotNull = true
+			i++
+		case tok.is("PRIMARY"):
+			column.PrimaryKey = true
+			column.NotNull = true
+		case tok.is("UNIQUE"):
+			column.Unique = true
+		case tok.is("DEFAULT"):
+			column.HasDefault = true
+		case tok.is("AUTO_INCREMENT"), tok.is("AUTOINCREMENT"), tok.is("IDENTITY"):
+			column.HasDefault = true
+		case tok.is("GENERATED"):
+			column.HasDefault = true
+		case tok.text == "(":
+			_, rest, _ := parenGroup(def[i:])
+			i = len(def) - len(rest) - 1
+		}
+	}
+
+	base := strings.Join(typeWords, " ")
+	if isSerialType(base) {
+		column.HasDefault = true
+		column.NotNull = true
+	}
+
+	goType := p.mapType(base, typeParams, isUnsigned)
+	if isArray {
+		goType = "[]" + goType
+	}
+	column.GoType = goType
+
+	return column, nil
+}
+
+func (p *DDLParser) mapType(base, params string, unsigned bool) string {
+	base = strings.TrimSpace(base)
+
+	switch base {
+	case "":
+		return "string"
+	case "bool", "boolean":
+		return "bool"
+	case "tinyint":
+		if p.dialect == dialect.MySQL && params == "1" {
+			return "bool"
+		}
+		return withUnsigned("int8", unsigned)
+	case "smallint", "int2", "smallserial", "serial2":
+		return withUnsigned("int16", unsigned)
+	case "mediumint", "int", "integer", "int4", "serial", "serial4":
+		if p.dialect == dialect.SQLite {
+			return "int64"
+		}
+		return withUnsigned("int32", unsigned)
+	case "bigint", "int8", "bigserial", "serial8":
+		return withUnsigned("int64", unsigned)
+	case "real", "float4":
+		if p.dialect == dialect.SQLite {
+			return "float64"
+		}
+		return "float32"
+	case "float", "float8", "double", "double precision", "numeric", "decimal", "money":
+		return "float64"
+	case "uuid", "uniqueidentifier":
+		return "uuid.UUID"
+	case "date", "datetime", "timestamp", "timestamptz",
+		"timestamp with time zone", "timestamp without time zone":
+		return "time.Time"
+	case "bytea", "blob", "tinyblob", "mediumblob", "longblob", "binary", "varbinary":
+		return "[]byte"
+	case "json", "jsonb":
+		return "json.RawMessage"
+	default:
+		return "string"
+	}
+}
+
+func withUnsigned(goType string, unsigned bool) string {
+	if unsigned {
+		return "u" + goType
+	}
+	return goType
+}
+
+func (p *DDLParser) nullableType(goType string) string {
+	if strings.HasPrefix(goType, "[]") || goType == "json.RawMessage" {
+		return goType
+	}
+
+	if p.nullStyle == NullSQL {
+		switch goType {
+		case "string":
+			return "sql.NullString"
+		case "bool":
+			return "sql.NullBool"
+		case "int16", "int8", "uint8":
+			return "sql.NullInt16"
+		case "int32", "uint16":
+			return "sql.NullInt32"
+		case "int64", "uint32":
+			return "sql.NullInt64"
+		case "float32", "float64":
+			return "sql.NullFloat64"
+		case "time.Time":
+			return "sql.NullTime"
+		case "uuid.UUID":
+			return "uuid.NullUUID"
+		}
+	}
+
+	return "*" + goType
+}
+
+func (p *DDLParser) buildEntity(table TableSchema) (models.EntityConfig, error) {
+	name := util.Singularize(util.ToPascalCase(table.Name))
+
+	if err := util.ValidatePascalCase(name); err != nil {
+		return models.EntityConfig{}, err
+	}
+
+	entity := models.EntityConfig{
+		Name:          name,
+		TableName:     table.Name,
+		AddValidation: true,
+		AddComments:   true,
+		JSONStyle:     "snake_case",
+	}
+	entity.IDStrategy, entity.Key = keyStrategy(table)
+	entity.UniqueKeys = table.UniqueKeys
+	entity.NoTimestamps = table.column("created_at") == nil || table.column("updated_at") == nil
+	entity.SoftDelete = table.column("deleted_at") != nil
+	entity.Audit = table.column("created_by") != nil && table.column("updated_by") != nil
+
+	implicit := entity.ImplicitFields()
+	for _, column := range table.Columns {
+		if models.HasColumn(implicit, strings.ToLower(column.Name)) {
+			continue
+		}
+
+		field, err := p.buildField(column)
+		if err != nil {
+			return models.EntityConfig{}, err
+		}
+		entity.Fields = append(entity.Fields, field)
+	}
+
+	return entity, nil
+}
+
+func keyStrategy(table TableSchema) (models.IDStrategy, []string) {
+	var key []string
+	for _, column := range table.Columns {
+		if column.PrimaryKey {
+			key = append(key, column.Name)
+		}
+	}
+	if len(key) > 1 || len(key) == 1 && !strings.EqualFold(key[0], "id") {
+		return models.IDComposite, key
+	}
+
+	id := table.column("id")
+	if id == nil {
+		return models.IDUUID, nil
+	}
+
+	switch id.GoType {
+	case "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64":
+		return models.IDInt64, nil
+	case "string":
+		return models.IDString, nil
+	default:
+		return models.IDUUID, nil
+	}
+}
+
+func (p *DDLParser) buildField(column ColumnSchema) (models.Field, error) {
+	field := models.Field{
+		Name:     util.ToPascalCase(column.Name),
+		Type:     column.GoType,
+		JSONTag:  util.ToSnakeCase(util.ToPascalCase(column.Name)),
+		DBTag:    column.Name,
+		Required: column.NotNull && !column.HasDefault && column.GoType != "bool",
+		Unique:   column.Unique,
+		Index:    column.Index,
+	}
+
+	if err := util.ValidatePascalCase(field.Name); err != nil {
+		return models.Field{}, fmt.Errorf("column %s: %w", column.Name, err)
+	}
+
+	if !column.NotNull {
+		field.Type = p.nullableType(column.GoType)
+	}
+
+	if field.Required {
+		field.Tags = append(field.Tags, "required")
+	}
+	if field.Unique {
+		field.Tags = append(field.Tags, "unique")
+	}
+	if field.Index {
+		field.Tags = append(field.Tags, "index")
+	}
+
+	return field, nil
+}
+
+func isTableConstraint(def []sqlToken) bool {
+	if def[0].kind != tokenWord || len(def) < 2 {
+		return false
+	}
+
+	switch def[0].upper() {
+	case "CONSTRAINT", "FOREIGN", "EXCLUDE":
+		return true
+	case "PRIMARY":
+		return def[1].is("KEY")
+	case "CHECK":
+		return def[1].text == "("
+	case "UNIQUE", "KEY", "INDEX", "FULLTEXT", "SPATIAL":
+		return !isSQLType(def[1])
+	default:
+		return false
+	}
+}
+
+func isSQLType(tok sqlToken) bool {
+	return tok.kind == tokenWord && sqlTypeNames[strings.ToLower(tok.text)]
+}
+
+var sqlTypeNames = map[string]bool{
+	"bool": true, "boolean": true, "bit": true, "varbit": true,
+	"tinyint": true, "smallint": true, "mediumint": true, "int": true, "integer": true, "bigint": true,
+	"int2": true, "int4": true, "int8": true,
+	"serial": true, "smallserial": true, "bigserial": true, "serial2": true, "serial4": true, "serial8": true,
+	"real": true, "float": true, "float4": true, "float8": true, "double": true,
+	"numeric": true, "decimal": true, "money": true,
+	"char": true, "character": true, "varchar": true, "nchar": true, "nvarchar": true,
+	"text": true, "tinytext": true, "mediumtext": true, "longtext": true, "citext": true,
+	"enum": true, "set": true, "uuid": true, "json": true, "jsonb": true, "xml": true,
+	"date": true, "datetime": true, "timestamp": true, "timestamptz": true,
+	"time": true, "timetz": true, "interval": true, "year": true,
+	"bytea": true, "blob": true, "tinyblob": true, "mediumblob": true, "longblob": true,
+	"binary": true, "varbinary": true, "inet": true, "cidr": true, "macaddr": true,
+}
+
+func isSerialType(base string) bool {
+	switch base {
+	case "serial", "smallserial", "bigserial", "serial2", "serial4", "serial8":
+		return true
+	default:
+		return false
+	}
+}
+
+func applyTableConstraint(table *TableSchema, def []sqlToken) {
+	if def[0].is("CONSTRAINT") && len(def) > 2 {
+		def = def[2:]
+	}
+
+	var columns []string
+	if group, _, ok := parenGroup(skipToParen(def)); ok {
+		columns = identifierList(group)
+	}
+
+	switch {
+	case def[0].is("PRIMARY"):
+		for _, name := range columns {
+			if column := table.column(name); column != nil {
+				column.PrimaryKey = true
+				column.NotNull = true
+			}
+		}
+	case def[0].is("UNIQUE"):
+		markIndex(table, columns, true)
+	case def[0].is("KEY"), def[0].is("INDEX"):
+		markIndex(table, columns, false)
+	}
+}
+
+func applyCreateIndex(tables []TableSchema, tokens []sqlToken, unique bool) error {
+	for len(tokens) > 0 && !tokens[0].is("ON") {
+		tokens = tokens[1:]
+	}
+	if len(tokens) == 0 {
+		return fmt.Errorf("CREATE INDEX without ON clause")
+	}
+
+	tableName, rest := parseQualifiedName(skipWords(tokens[1:], "ONLY"))
+
+	group, _, ok := parenGroup(skipToParen(rest))
+	if !ok {
+		return fmt.Errorf("index on %s: expected column list", tableName)
+	}
+
+	for i := range tables {
+		if strings.EqualFold(tables[i].Name, tableName) {
+			markIndex(&tables[i], identifierList(group), unique)
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func markIndex(table *TableSchema, columns []string, unique bool) {
+	if unique && len(columns) > 1 {
+		var key []string
+		for _, name := range columns {
+			if column := table.column(name); column != nil {
+				key = append(key, column.Name)
+			}
+		}
+		if len(key) == len(columns) {
+			table.UniqueKeys = append(table.UniqueKeys, key)
+		}
+		return
+	}
+
+	for _, name := range columns {
+		column := table.column(name)
+		if column == nil {
+			continue
+		}
+
+		if unique {
+			column.Unique = true
+		} else {
+			column.Index = true
+		}
+	}
+}
+
+func isColumnConstraintKeyword(word string) bool {
+	switch word {
+	case "NOT", "NULL", "PRIMARY", "UNIQUE", "DEFAULT", "REFERENCES", "CHECK",
+		"CONSTRAINT", "AUTO_INCREMENT", "AUTOINCREMENT", "IDENTITY", "COLLATE",
+		"GENERATED", "COMMENT", "ON", "CHARSET", "AS":
+		return true
+	default:
+		return false
+	}
+}
+
+func parseQualifiedName(tokens []sqlToken) (string, []sqlToken) {
+	var name string
+
+	for len(tokens) > 0 && (tokens[0].kind == tokenWord || tokens[0].kind == tokenQuoted) {
+		name = tokens[0].text
+		tokens = tokens[1:]
+
+		if len(tokens) == 0 || tokens[0].text != "." {
+			break
+		}
+		tokens = tokens[1:]
+	}
+
+	return name, tokens
+}
+
+func identifierList(tokens []sqlToken) []string {
+	var names []string
+
+	for _, part := range splitTopLevel(tokens) {
+		if len(part) > 0 && (part[0].kind == tokenWord || part[0].kind == tokenQuoted) {
+			names = append(names, part[0].text)
+		}
+	}
+
+	return names
+}
+
+func skipWords(tokens []sqlToken, words ...string) []sqlToken {
+	for len(tokens) > 0 {
+		skipped := false
+		for _, word := range words {
+			if tokens[0].is(word) {
+				tokens = tokens[1:]
+				skipped = true
+				break
+			}
+		}
+		if !skipped {
+			break
+		}
+	}
+	return tokens
+}
+
+func skipToParen(tokens []sqlToken) []sqlToken {
+	for len(tokens) > 0 && tokens[0].text != "(" {
+		tokens = tokens[1:]
+	}
+	return tokens
+}
+
+func parenGroup(tokens []sqlToken) ([]sqlToken, []sqlToken, bool) {
+	if len(tokens) == 0 || tokens[0].text != "(" {
+		return nil, tokens, false
+	}
+
+	depth := 0
+	for i, tok := range tokens {
+		if tok.kind != tokenPunct {
+			continue
+		}
+		switch tok.text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+			if depth == 0 {
+				return tokens[1:i], tokens[i+1:], true
+			}
+		}
+	}
+
+	return nil, tokens, false
+}
+
+func splitTopLevel(tokens []sqlToken) [][]sqlToken {
+	var parts [][]sqlToken
+	depth := 0
+	start := 0
+
+	for i, tok := range tokens {
+		if tok.kind != tokenPunct {
+			continue
+		}
+		switch tok.text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		case ",":
+			if depth == 0 {
+				parts = append(parts, tokens[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(parts, tokens[start:])
+}
+
+func splitStatements(tokens []sqlToken) [][]sqlToken {
+	var statements [][]sqlToken
+	start := 0
+
+	for i, tok := range tokens {
+		if tok.kind == tokenPunct && tok.text == ";" {
+			statements = append(statements, tokens[start:i])
+			start = i + 1
+		}
+	}
+
+	return append(statements, tokens[start:])
+}
+
+func joinTokens(tokens []sqlToken) string {
+	parts := make([]string, 0, len(tokens))
+	for _, tok := range tokens {
+		parts = append(parts, tok.text)
+	}
+	return strings.Join(parts, "")
+}
+
+type tokenKind int
+
+const (
+	tokenWord tokenKind = iota
+	tokenQuoted
+	tokenString
+	tokenPunct
+)
+
+type sqlToken struct {
+	kind tokenKind
+	text string
+}
+
+func (t sqlToken) upper() string {
+	return strings.ToUpper(t.text)
+}
+
+func (t sqlToken) is(word string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, word)
+}
+
+func tokenizeSQL(input string) ([]sqlToken, error) {
+	var tokens []sqlToken
+	runes := []rune(input)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-', r == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i+1 < len(runes) && !(runes[i] == '*' && runes[i+1] == '/') {
+				i++
+			}
+			if i+1 >= len(runes) {
+				return nil, fmt.Errorf("unterminated block comment")
+			}
+			i += 2
+		case r == '\'' || r == '"' || r == '`' || r == '[' && !lastIsWord(tokens):
+			closing := r
+			kind := tokenQuoted
+			if r == '[' {
+				closing = ']'
+			}
+			if r == '\'' {
+				kind = tokenString
+			}
+
+			j := i + 1
+			var text strings.Builder
+			for ; j < len(runes); j++ {
+				if runes[j] == closing {
+					if j+1 < len(runes) && runes[j+1] == closing && closing != ']' {
+						text.WriteRune(closing)
+						j++
+						continue
+					}
+					break
+				}
+				text.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated quoted literal")
+			}
+
+			tokens = append(tokens, sqlToken{kind: kind, text: text.String()})
+			i = j + 1
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '$':
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) ||
+				runes[j] == '_' || runes[j] == '$') {
+				j++
+			}
+			tokens = append(tokens, sqlToken{kind: tokenWord, text: string(runes[i:j])})
+			i = j
+		default:
+			tokens = append(tokens, sqlToken{kind: tokenPunct, text: string(r)})
+			i++
+		}
+	}
+
+	return tokens, nil
+}
+
+func lastIsWord(tokens []sqlToken) bool {
+	return len(tokens) > 0 && tokens[len(tokens)-1].kind == tokenWord
+}
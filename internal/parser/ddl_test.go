@@ -0,0 +1,227 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"gogen/pkg/models"
+)
+
+func TestDDLParser_ParseEntities(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  string
+		ddl      string
+		strategy models.IDStrategy
+		key      []string
+		unique   [][]string
+		fields   map[string]string
+		flags    map[string]string
+	}{
+		{
+			name:     "postgres bigserial id",
+			dialect:  "postgres",
+			ddl:      `CREATE TABLE users (id BIGSERIAL PRIMARY KEY, email TEXT NOT NULL UNIQUE, bio TEXT, created_at TIMESTAMPTZ NOT NULL, updated_at TIMESTAMPTZ NOT NULL);`,
+			strategy: models.IDInt64,
+			fields:   map[string]string{"Email": "string", "Bio": "*string"},
+			flags:    map[string]string{"Email": "required,unique"},
+		},
+		{
+			name:     "postgres uuid id",
+			dialect:  "postgres",
+			ddl:      `CREATE TABLE IF NOT EXISTS public.orders (id UUID PRIMARY KEY DEFAULT gen_random_uuid(), total NUMERIC(10, 2) NOT NULL);`,
+			strategy: models.IDUUID,
+			fields:   map[string]string{"Total": "float64"},
+			flags:    map[string]string{"Total": "required"},
+		},
+		{
+			name:     "postgres natural key",
+			dialect:  "postgres",
+			ddl:      `CREATE TABLE countries (code CHAR(2) PRIMARY KEY, name TEXT NOT NULL);`,
+			strategy: models.IDComposite,
+			key:      []string{"code"},
+			fields:   map[string]string{"Code": "string", "Name": "string"},
+		},
+		{
+			name:    "postgres composite unique",
+			dialect: "postgres",
+			ddl: `CREATE TABLE members (id UUID PRIMARY KEY, team_id UUID NOT NULL, user_id UUID NOT NULL, CONSTRAINT uq_members UNIQUE (team_id, user_id));
+				CREATE INDEX idx_members_user ON members (user_id);`,
+			strategy: models.IDUUID,
+			unique:   [][]string{{"team_id", "user_id"}},
+			fields:   map[string]string{"TeamID": "uuid.UUID", "UserID": "uuid.UUID"},
+			flags:    map[string]string{"TeamID": "required", "UserID": "required,index"},
+		},
+		{
+			name:     "postgres composite primary key",
+			dialect:  "postgres",
+			ddl:      `CREATE TABLE lines (order_id UUID NOT NULL, pos INT NOT NULL, PRIMARY KEY (order_id, pos));`,
+			strategy: models.IDComposite,
+			key:      []string{"order_id", "pos"},
+			fields:   map[string]string{"OrderID": "uuid.UUID", "Pos": "int32"},
+		},
+		{
+			name:     "mysql unsigned auto increment",
+			dialect:  "mysql",
+			ddl:      "CREATE TABLE `users` (`id` BIGINT UNSIGNED NOT NULL AUTO_INCREMENT, `active` TINYINT(1) NOT NULL DEFAULT 1, PRIMARY KEY (`id`)) ENGINE=InnoDB;",
+			strategy: models.IDInt64,
+			fields:   map[string]string{"Active": "bool"},
+		},
+		{
+			name:     "mysql unique key",
+			dialect:  "mysql",
+			ddl:      "CREATE TABLE accounts (id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY, tenant_id INT NOT NULL, email VARCHAR(255) NOT NULL, UNIQUE KEY uq_tenant_email (tenant_id, email), KEY idx_email (email));",
+			strategy: models.IDInt64,
+			unique:   [][]string{{"tenant_id", "email"}},
+			fields:   map[string]string{"TenantID": "int32", "Email": "string"},
+			flags:    map[string]string{"TenantID": "required", "Email": "required,index"},
+		},
+		{
+			name:     "postgres initialism columns",
+			dialect:  "postgres",
+			ddl:      `CREATE TABLE sessions (id UUID PRIMARY KEY, user_id UUID NOT NULL, ip_address INET, api_url TEXT);`,
+			strategy: models.IDUUID,
+			fields:   map[string]string{"UserID": "uuid.UUID", "IPAddress": "*string", "APIURL": "*string"},
+			flags:    map[string]string{"UserID": "required"},
+		},
+		{
+			name:     "mysql unique index",
+			dialect:  "mysql",
+			ddl:      "CREATE TABLE tags (id CHAR(36) PRIMARY KEY, slug VARCHAR(64) NOT NULL); CREATE UNIQUE INDEX uq_tags_slug ON tags (slug);",
+			strategy: models.IDString,
+			fields:   map[string]string{"Slug": "string"},
+			flags:    map[string]string{"Slug": "required,unique"},
+		},
+		{
+			name:     "sqlite integer primary key",
+			dialect:  "sqlite",
+			ddl:      `CREATE TABLE notes (id INTEGER PRIMARY KEY AUTOINCREMENT, body TEXT NOT NULL, score REAL);`,
+			strategy: models.IDInt64,
+			fields:   map[string]string{"Body": "string", "Score": "*float64"},
+		},
+		{
+			name:     "sqlite without primary key",
+			dialect:  "sqlite",
+			ddl:      `CREATE TABLE events (name TEXT NOT NULL);`,
+			strategy: models.IDUUID,
+			fields:   map[string]string{"Name": "string"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entities, err := NewDDLParser(tt.dialect, "").ParseEntities(tt.ddl)
+			if err != nil {
+				t.Fatalf("ParseEntities() error = %v", err)
+			}
+			if len(entities) != 1 {
+				t.Fatalf("got %d entities, want 1", len(entities))
+			}
+			entity := entities[0]
+
+			if entity.IDStrategy != tt.strategy {
+				t.Errorf("IDStrategy = %q, want %q", entity.IDStrategy, tt.strategy)
+			}
+			if !reflect.DeepEqual(entity.Key, tt.key) {
+				t.Errorf("Key = %v, want %v", entity.Key, tt.key)
+			}
+			if !reflect.DeepEqual(entity.UniqueKeys, tt.unique) {
+				t.Errorf("UniqueKeys = %v, want %v", entity.UniqueKeys, tt.unique)
+			}
+
+			for name, typ := range tt.fields {
+				field := findField(entity.Fields, name)
+				if field == nil {
+					t.Errorf("field %s not found", name)
+					continue
+				}
+				if field.Type != typ {
+					t.Errorf("field %s type = %q, want %q", name, field.Type, typ)
+				}
+			}
+
+			for name, flags := range tt.flags {
+				if field := findField(entity.Fields, name); field != nil {
+					if got := fieldFlags(*field); got != flags {
+						t.Errorf("field %s flags = %q, want %q", name, got, flags)
+					}
+				}
+			}
+
+			if key := entity.PrimaryKey(); !key.Composite() && findField(entity.Fields, "ID") != nil {
+				t.Errorf("implicit id column duplicated in fields")
+			}
+		})
+	}
+}
+
+func TestDDLParser_ParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		ddl  string
+	}{
+		{name: "no tables", ddl: `CREATE INDEX idx ON users (email);`},
+		{name: "no columns", ddl: `CREATE TABLE empty ();`},
+		{name: "missing column list", ddl: `CREATE TABLE users;`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewDDLParser("postgres", "").Parse(tt.ddl); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestDDLParser_NullStyle(t *testing.T) {
+	tests := []struct {
+		style string
+		want  map[string]string
+	}{
+		{style: NullPointer, want: map[string]string{"Name": "*string", "Age": "*int32", "SeenAt": "*time.Time"}},
+		{style: NullSQL, want: map[string]string{"Name": "sql.NullString", "Age": "sql.NullInt32", "SeenAt": "sql.NullTime"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.style, func(t *testing.T) {
+			entities, err := NewDDLParser("postgres", tt.style).ParseEntities(
+				`CREATE TABLE people (id UUID PRIMARY KEY, name TEXT, age INTEGER, seen_at TIMESTAMP);`)
+			if err != nil {
+				t.Fatalf("ParseEntities() error = %v", err)
+			}
+
+			for name, typ := range tt.want {
+				if field := findField(entities[0].Fields, name); field == nil || field.Type != typ {
+					t.Errorf("field %s = %+v, want type %q", name, field, typ)
+				}
+			}
+		})
+	}
+}
+
+func findField(fields []models.Field, name string) *models.Field {
+	for i := range fields {
+		if fields[i].Name == name {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+func fieldFlags(field models.Field) string {
+	var flags string
+	for _, flag := range []struct {
+		name string
+		set  bool
+	}{{"required", field.Required}, {"unique", field.Unique}, {"index", field.Index}} {
+		if !flag.set {
+			continue
+		}
+		if flags != "" {
+			flags += ","
+		}
+		flags += flag.name
+	}
+	return flags
+}
@@ -2,12 +2,34 @@ package parser
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	"gogen/internal/util"
 	"gogen/pkg/models"
 )
 
+var enumPattern = regexp.MustCompile(`^(\*?)(\w+)\((.*)\)$`)
+
+const (
+	tagRequired = "required"
+	tagUnique   = "unique"
+	tagIndex    = "index"
+)
+
+// FieldTags lists the tags accepted after Name:Type, grouped as flags, validation
+// rules and relations.
+func FieldTags() (flags, rules, relations []string) {
+	flags = []string{tagRequired, tagUnique, tagIndex}
+	for _, kind := range models.ValidationRuleKinds {
+		rules = append(rules, string(kind))
+	}
+	for _, kind := range models.RelationKinds {
+		relations = append(relations, string(kind))
+	}
+	return flags, rules, relations
+}
+
 type FieldParser struct{}
 
 func NewFieldParser() *FieldParser {
@@ -19,7 +41,7 @@ func (fp *FieldParser) Parse(input string) ([]models.Field, error) {
 		return []models.Field{}, nil
 	}
 
-	fieldStrings := strings.Split(input, ",")
+	fieldStrings := splitFields(input)
 	fields := make([]models.Field, 0, len(fieldStrings))
 
 	for _, fieldStr := range fieldStrings {
@@ -33,8 +55,12 @@ func (fp *FieldParser) Parse(input string) ([]models.Field, error) {
 	return fields, nil
 }
 
+func (fp *FieldParser) ParseField(input string) (models.Field, error) {
+	return fp.parseField(strings.TrimSpace(input))
+}
+
 func (fp *FieldParser) parseField(input string) (models.Field, error) {
-	parts := strings.Split(input, ":")
+	parts := strings.SplitN(input, ":", 3)
 
 	if len(parts) < 2 {
 		return models.Field{}, fmt.Errorf("invalid format, expected Name:Type[:tags]")
@@ -49,33 +75,144 @@ func (fp *FieldParser) parseField(input string) (models.Field, error) {
 		return models.Field{}, fmt.Errorf("invalid field name: %w", err)
 	}
 
+	if m := enumPattern.FindStringSubmatch(field.Type); m != nil {
+		values, err := parseEnumValues(m[3])
+		if err != nil {
+			return models.Field{}, fmt.Errorf("invalid enum %s: %w", m[2], err)
+		}
+		field.Type = m[1] + m[2]
+		field.Enum = values
+	}
+
+	if field.BaseType() == "enum" && !field.IsEnum() {
+		return models.Field{}, fmt.Errorf("enum type requires values, e.g. enum(pending|paid)")
+	}
+
 	if err := util.ValidateType(field.Type); err != nil {
 		return models.Field{}, fmt.Errorf("invalid field type: %w", err)
 	}
 
 	if len(parts) >= 3 {
-		tags := strings.Split(parts[2], ",")
+		tags := splitList(parts[2])
 		for _, tag := range tags {
 			tag = strings.TrimSpace(tag)
 			field.Tags = append(field.Tags, tag)
 
 			switch tag {
-			case "required":
+			case tagRequired:
 				field.Required = true
-			case "unique":
+			case tagUnique:
 				field.Unique = true
-			case "index":
+			case tagIndex:
 				field.Index = true
+			default:
+				if kind, ok := models.ParseRelationKind(tag); ok {
+					field.Relation = kind
+					continue
+				}
+
+				rule, ok, err := parseValidationRule(field, tag)
+				if err != nil {
+					return models.Field{}, err
+				}
+				if ok {
+					field.Rules = append(field.Rules, rule)
+				}
 			}
 		}
 	}
 
+	if field.Relation != "" {
+		if err := validateRelation(field); err != nil {
+			return models.Field{}, err
+		}
+	}
+
 	field.JSONTag = util.ToSnakeCase(field.Name)
 	field.DBTag = util.ToSnakeCase(field.Name)
 
 	return field, nil
 }
 
+func validateRelation(field models.Field) error {
+	target := strings.TrimPrefix(field.Type, "*")
+	many := strings.HasPrefix(target, "[]")
+	target = strings.TrimPrefix(strings.TrimPrefix(target, "[]"), "*")
+
+	if err := util.ValidatePascalCase(target); err != nil {
+		return fmt.Errorf("%s relation must reference an entity: %w", field.Relation, err)
+	}
+
+	switch {
+	case field.Relation == models.RelationBelongsTo && many:
+		return fmt.Errorf("belongs_to relation must reference a single entity, got %s", field.Type)
+	case field.Relation != models.RelationBelongsTo && !many:
+		return fmt.Errorf("%s relation must reference a slice of entities, got %s", field.Relation, field.Type)
+	}
+
+	return nil
+}
+
+var fieldStart = regexp.MustCompile(`^\s*[A-Za-z_]\w*\s*:`)
+
+func splitFields(input string) []string {
+	var fields []string
+	for _, part := range splitList(input) {
+		if len(fields) > 0 && !fieldStart.MatchString(part) {
+			fields[len(fields)-1] += "," + part
+			continue
+		}
+		fields = append(fields, part)
+	}
+	return fields
+}
+
+func splitList(input string) []string {
+	var parts []string
+	depth, start := 0, 0
+
+	for i, r := range input {
+		switch r {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, input[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(parts, input[start:])
+}
+
+func parseEnumValues(input string) ([]string, error) {
+	seen := make(map[string]bool)
+	var values []string
+
+	for _, value := range strings.Split(input, "|") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("empty value")
+		}
+		if strings.ContainsAny(value, "'\"`\\") {
+			return nil, fmt.Errorf("value %q must not contain quotes", value)
+		}
+		if util.ToPascalIdentifier(value) == "" {
+			return nil, fmt.Errorf("value %q has no letters or digits", value)
+		}
+		if seen[value] {
+			return nil, fmt.Errorf("duplicate value %q", value)
+		}
+		seen[value] = true
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
 func (fp *FieldParser) ParseJSON(input string) ([]models.Field, error) {
 
 	return nil, fmt.Errorf("JSON parsing not implemented yet")
@@ -0,0 +1,141 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+type MethodParser struct{}
+
+func NewMethodParser() *MethodParser {
+	return &MethodParser{}
+}
+
+func (mp *MethodParser) Parse(input string) (models.CustomMethod, error) {
+	input = strings.TrimSpace(input)
+
+	open := strings.Index(input, "(")
+	if open <= 0 {
+		return models.CustomMethod{}, fmt.Errorf("invalid format, expected Name(params) results")
+	}
+
+	name := strings.TrimSpace(input[:open])
+	if err := util.ValidatePascalCase(name); err != nil {
+		return models.CustomMethod{}, fmt.Errorf("invalid method name: %w", err)
+	}
+
+	expr, err := goparser.ParseExpr("func" + input[open:])
+	if err != nil {
+		return models.CustomMethod{}, fmt.Errorf("invalid signature: %w", err)
+	}
+
+	fn, ok := expr.(*ast.FuncType)
+	if !ok {
+		return models.CustomMethod{}, fmt.Errorf("invalid signature: unexpected method body")
+	}
+
+	method := models.CustomMethod{
+		Name:    name,
+		Comment: fmt.Sprintf("%s кастомный метод репозитория", name),
+		Params:  fieldParams(fn.Params, "arg"),
+	}
+
+	if len(method.Params) > 0 && method.Params[0].Type == "context.Context" {
+		method.Params = method.Params[1:]
+	}
+
+	for _, result := range fieldParams(fn.Results, "") {
+		method.Returns = append(method.Returns, result.Type)
+		if result.Name != "" {
+			method.ResultNames = append(method.ResultNames, result.Name)
+		}
+	}
+
+	if len(method.Returns) == 0 {
+		method.Returns = []string{"error"}
+	}
+
+	return method, nil
+}
+
+func fieldParams(list *ast.FieldList, prefix string) []models.MethodParam {
+	if list == nil {
+		return nil
+	}
+
+	var params []models.MethodParam
+	for _, field := range list.List {
+		typ := types.ExprString(field.Type)
+
+		if len(field.Names) == 0 {
+			name := ""
+			if prefix != "" {
+				name = fmt.Sprintf("%s%d", prefix, len(params))
+			}
+			params = append(params, models.MethodParam{Name: name, Type: typ})
+			continue
+		}
+
+		for _, ident := range field.Names {
+			params = append(params, models.MethodParam{Name: ident.Name, Type: typ})
+		}
+	}
+
+	return params
+}
+
+func QualifyType(typeName, pkg string) string {
+	return rewriteType(typeName, func(c *astutil.Cursor) bool {
+		ident, ok := c.Node().(*ast.Ident)
+		if !ok || !ident.IsExported() {
+			return true
+		}
+		if _, isSelector := c.Parent().(*ast.SelectorExpr); isSelector {
+			return true
+		}
+		if _, isField := c.Parent().(*ast.Field); isField && c.Name() == "Names" {
+			return true
+		}
+
+		c.Replace(&ast.SelectorExpr{X: ast.NewIdent(pkg), Sel: ast.NewIdent(ident.Name)})
+		return false
+	})
+}
+
+func UnqualifyType(typeName, pkg string) string {
+	return rewriteType(typeName, func(c *astutil.Cursor) bool {
+		selector, ok := c.Node().(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if x, ok := selector.X.(*ast.Ident); ok && x.Name == pkg {
+			c.Replace(ast.NewIdent(selector.Sel.Name))
+			return false
+		}
+		return true
+	})
+}
+
+func rewriteType(typeName string, pre astutil.ApplyFunc) string {
+	variadic := strings.HasPrefix(typeName, "...")
+
+	expr, err := goparser.ParseExprFrom(token.NewFileSet(), "", strings.TrimPrefix(typeName, "..."), 0)
+	if err != nil {
+		return typeName
+	}
+
+	rewritten := types.ExprString(astutil.Apply(expr, pre, nil).(ast.Expr))
+	if variadic {
+		return "..." + rewritten
+	}
+	return rewritten
+}
@@ -0,0 +1,135 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+
+	"gogen/pkg/models"
+)
+
+func TestMethodParser_Parse(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		params      []models.MethodParam
+		returns     []string
+		resultNames []string
+	}{
+		{
+			name:    "context is dropped",
+			input:   "FindByEmail(ctx context.Context, email string) (*User, error)",
+			params:  []models.MethodParam{{Name: "email", Type: "string"}},
+			returns: []string{"*User", "error"},
+		},
+		{
+			name:    "no results default to error",
+			input:   "Touch(id uuid.UUID)",
+			params:  []models.MethodParam{{Name: "id", Type: "uuid.UUID"}},
+			returns: []string{"error"},
+		},
+		{
+			name:    "grouped params",
+			input:   "Between(from, to time.Time) ([]*User, error)",
+			params:  []models.MethodParam{{Name: "from", Type: "time.Time"}, {Name: "to", Type: "time.Time"}},
+			returns: []string{"[]*User", "error"},
+		},
+		{
+			name:    "unnamed params",
+			input:   "Count(string, int) (int64, error)",
+			params:  []models.MethodParam{{Name: "arg0", Type: "string"}, {Name: "arg1", Type: "int"}},
+			returns: []string{"int64", "error"},
+		},
+		{
+			name:    "variadic",
+			input:   "FindByIDs(ctx context.Context, ids ...uuid.UUID) ([]*User, error)",
+			params:  []models.MethodParam{{Name: "ids", Type: "...uuid.UUID"}},
+			returns: []string{"[]*User", "error"},
+		},
+		{
+			name:        "named results",
+			input:       "Stats(ctx context.Context) (total int64, active int64, err error)",
+			params:      []models.MethodParam{},
+			returns:     []string{"int64", "int64", "error"},
+			resultNames: []string{"total", "active", "err"},
+		},
+		{
+			name:    "qualified and composite types",
+			input:   "Search(filter map[string][]sql.NullString, limit *int) (map[uuid.UUID]*User, error)",
+			params:  []models.MethodParam{{Name: "filter", Type: "map[string][]sql.NullString"}, {Name: "limit", Type: "*int"}},
+			returns: []string{"map[uuid.UUID]*User", "error"},
+		},
+		{
+			name:    "single unparenthesized result",
+			input:   "Exists(email string) bool",
+			params:  []models.MethodParam{{Name: "email", Type: "string"}},
+			returns: []string{"bool"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method, err := NewMethodParser().Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(method.Params, tt.params) {
+				t.Errorf("Params = %v, want %v", method.Params, tt.params)
+			}
+			if !reflect.DeepEqual(method.Returns, tt.returns) {
+				t.Errorf("Returns = %v, want %v", method.Returns, tt.returns)
+			}
+			if !reflect.DeepEqual(method.ResultNames, tt.resultNames) {
+				t.Errorf("ResultNames = %v, want %v", method.ResultNames, tt.resultNames)
+			}
+		})
+	}
+}
+
+func TestMethodParser_ParseErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "no params", input: "FindAll"},
+		{name: "lowercase name", input: "findAll() error"},
+		{name: "unbalanced parens", input: "Find(id string error"},
+		{name: "variadic not last", input: "Find(ids ...string, limit int) error"},
+		{name: "method body", input: "Find() error { return nil }"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewMethodParser().Parse(tt.input); err == nil {
+				t.Error("Parse() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestQualifyType(t *testing.T) {
+	tests := []struct {
+		typeName  string
+		qualified string
+	}{
+		{typeName: "User", qualified: "domain.User"},
+		{typeName: "*User", qualified: "*domain.User"},
+		{typeName: "[]*User", qualified: "[]*domain.User"},
+		{typeName: "map[UserID]*User", qualified: "map[domain.UserID]*domain.User"},
+		{typeName: "...Status", qualified: "...domain.Status"},
+		{typeName: "uuid.UUID", qualified: "uuid.UUID"},
+		{typeName: "string", qualified: "string"},
+		{typeName: "func(User) error", qualified: "func(domain.User) error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typeName, func(t *testing.T) {
+			if got := QualifyType(tt.typeName, "domain"); got != tt.qualified {
+				t.Errorf("QualifyType() = %q, want %q", got, tt.qualified)
+			}
+			if got := UnqualifyType(tt.qualified, "domain"); got != tt.typeName {
+				t.Errorf("UnqualifyType() = %q, want %q", got, tt.typeName)
+			}
+		})
+	}
+}
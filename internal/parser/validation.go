@@ -0,0 +1,101 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gogen/pkg/models"
+)
+
+func parseValidationRule(field models.Field, tag string) (models.ValidationRule, bool, error) {
+	name, param, _ := strings.Cut(tag, "=")
+
+	kind, ok := models.ParseValidationRuleKind(strings.TrimSpace(name))
+	if !ok {
+		return models.ValidationRule{}, false, nil
+	}
+
+	rule := models.ValidationRule{Kind: kind, Param: strings.TrimSpace(param)}
+	if err := validateRule(field, rule); err != nil {
+		return models.ValidationRule{}, true, fmt.Errorf("invalid rule %s: %w", tag, err)
+	}
+
+	return rule, true, nil
+}
+
+func validateRule(field models.Field, rule models.ValidationRule) error {
+	kind := field.ValueKind()
+
+	switch rule.Kind {
+	case models.RuleMin, models.RuleMax, models.RuleLen:
+		if rule.Param == "" {
+			return fmt.Errorf("value is required, e.g. %s=3", rule.Kind)
+		}
+		switch {
+		case kind == "string" || kind == "collection":
+			if n, err := strconv.Atoi(rule.Param); err != nil || n < 0 {
+				return fmt.Errorf("expected a non-negative integer, got %q", rule.Param)
+			}
+		case rule.Kind == models.RuleLen:
+			return fmt.Errorf("not supported for %s", field.Type)
+		default:
+			return parseNumber(kind, field.Type, rule.Param)
+		}
+
+	case models.RuleEmail, models.RuleURL:
+		if rule.Param != "" {
+			return fmt.Errorf("takes no value")
+		}
+		if kind != "string" {
+			return fmt.Errorf("not supported for %s", field.Type)
+		}
+
+	case models.RuleRegex:
+		if kind != "string" {
+			return fmt.Errorf("not supported for %s", field.Type)
+		}
+		if rule.Param == "" {
+			return fmt.Errorf("pattern is required")
+		}
+		if _, err := regexp.Compile(rule.Param); err != nil {
+			return err
+		}
+
+	case models.RuleOneOf:
+		values := rule.Values()
+		if len(values) == 0 {
+			return fmt.Errorf("values are required, e.g. oneof=a|b")
+		}
+		if kind == "string" {
+			return nil
+		}
+		for _, value := range values {
+			if err := parseNumber(kind, field.Type, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseNumber(kind, typeName, value string) error {
+	var err error
+	switch kind {
+	case "int":
+		_, err = strconv.ParseInt(value, 10, 64)
+	case "uint":
+		_, err = strconv.ParseUint(value, 10, 64)
+	case "float":
+		_, err = strconv.ParseFloat(value, 64)
+	default:
+		return fmt.Errorf("not supported for %s", typeName)
+	}
+
+	if err != nil {
+		return fmt.Errorf("%q is not a valid %s", value, typeName)
+	}
+	return nil
+}
@@ -1,12 +1,18 @@
 package project
 
 import (
+	"fmt"
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"go/types"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
+
+	"gogen/internal/util"
+	"gogen/pkg/models"
 )
 
 type Analyzer struct {
@@ -63,6 +69,72 @@ func (a *Analyzer) FindExistingEntities(domainPath string) ([]string, error) {
 }
 
 func (a *Analyzer) extractStructNames(filePath string) ([]string, error) {
+	specs, err := a.parseStructs(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+
+	for _, typeSpec := range specs {
+		names = append(names, typeSpec.Name.Name)
+	}
+
+	return names, nil
+}
+
+func (a *Analyzer) ExtractStructFields(path, structName string) ([]models.Field, error) {
+	root, err := a.finder.FindRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	specs, err := a.parseStructs(filepath.Join(root, path))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, typeSpec := range specs {
+		if typeSpec.Name.Name != structName {
+			continue
+		}
+
+		structType := typeSpec.Type.(*ast.StructType)
+
+		var fields []models.Field
+
+		for _, astField := range structType.Fields.List {
+			var tag reflect.StructTag
+			if astField.Tag != nil {
+				tag = reflect.StructTag(strings.Trim(astField.Tag.Value, "`"))
+			}
+
+			for _, ident := range astField.Names {
+				field := models.Field{
+					Name:    ident.Name,
+					Type:    types.ExprString(astField.Type),
+					JSONTag: tagName(tag.Get("json")),
+					DBTag:   tagName(tag.Get("db")),
+				}
+
+				if field.DBTag == "-" {
+					continue
+				}
+				if field.DBTag == "" {
+					field.DBTag = util.ToSnakeCase(field.Name)
+				}
+
+				fields = append(fields, field)
+			}
+		}
+
+		return fields, nil
+	}
+
+	return nil, fmt.Errorf("struct %s not found in %s", structName, path)
+}
+
+func (a *Analyzer) parseStructs(filePath string) ([]*ast.TypeSpec, error) {
 	fset := token.NewFileSet()
 
 	node, err := parser.ParseFile(fset, filePath, nil, parser.ParseComments)
@@ -70,7 +142,7 @@ func (a *Analyzer) extractStructNames(filePath string) ([]string, error) {
 		return nil, err
 	}
 
-	var names []string
+	var specs []*ast.TypeSpec
 
 	for _, decl := range node.Decls {
 
@@ -86,12 +158,17 @@ func (a *Analyzer) extractStructNames(filePath string) ([]string, error) {
 			}
 
 			if _, ok := typeSpec.Type.(*ast.StructType); ok {
-				names = append(names, typeSpec.Name.Name)
+				specs = append(specs, typeSpec)
 			}
 		}
 	}
 
-	return names, nil
+	return specs, nil
+}
+
+func tagName(value string) string {
+	name, _, _ := strings.Cut(value, ",")
+	return name
 }
 
 func (a *Analyzer) FindExistingRepositories(repoPath string) ([]string, error) {
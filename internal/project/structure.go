@@ -17,6 +17,7 @@ func (f *Finder) EnsureStructure(config *models.Config) error {
 	paths := []string{
 		config.Paths.Domain,
 		config.Paths.Repository,
+		config.Paths.InMemory,
 		config.Paths.UseCase,
 		config.Paths.Handler,
 		config.Paths.Mocks,
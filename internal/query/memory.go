@@ -0,0 +1,90 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (m *Method) GoMatch(entity string) string {
+	groups := make([]string, len(m.Groups))
+	for i, group := range m.Groups {
+		conditions := make([]string, len(group))
+		for j, cond := range group {
+			conditions[j] = goCondition(cond, entity)
+		}
+
+		groups[i] = strings.Join(conditions, " && ")
+		if len(m.Groups) > 1 && len(group) > 1 {
+			groups[i] = "(" + groups[i] + ")"
+		}
+	}
+	return strings.Join(groups, " || ")
+}
+
+func goCondition(cond Condition, entity string) string {
+	value := entity + "." + cond.Field.Name
+	pointer := cond.Field.IsPointer()
+
+	var param, second string
+	if len(cond.Params) > 0 {
+		param = cond.Params[0]
+	}
+	if len(cond.Params) > 1 {
+		second = cond.Params[1]
+	}
+
+	switch cond.Operator {
+	case OpIsNull:
+		if !pointer {
+			return "false"
+		}
+		return value + " == nil"
+	case OpIsNotNull:
+		if !pointer {
+			return "true"
+		}
+		return value + " != nil"
+	}
+
+	deref := value
+	if pointer {
+		deref = "*" + value
+	}
+
+	var expr string
+	switch cond.Operator {
+	case OpTrue:
+		expr = deref
+	case OpFalse:
+		expr = "!" + deref
+	case OpNot:
+		expr = fmt.Sprintf("compareValues(%s, %s) != 0", value, param)
+	case OpLessThan, OpBefore:
+		expr = fmt.Sprintf("compareValues(%s, %s) < 0", value, param)
+	case OpLessEqual:
+		expr = fmt.Sprintf("compareValues(%s, %s) <= 0", value, param)
+	case OpGreaterThan, OpAfter:
+		expr = fmt.Sprintf("compareValues(%s, %s) > 0", value, param)
+	case OpGreaterEqual:
+		expr = fmt.Sprintf("compareValues(%s, %s) >= 0", value, param)
+	case OpBetween:
+		expr = fmt.Sprintf("compareValues(%s, %s) >= 0 && compareValues(%s, %s) <= 0", value, param, value, second)
+	case OpLike:
+		expr = fmt.Sprintf("matchLike(fmt.Sprint(%s), fmt.Sprint(%s))", deref, param)
+	case OpNotLike:
+		expr = fmt.Sprintf("!matchLike(fmt.Sprint(%s), fmt.Sprint(%s))", deref, param)
+	case OpStartingWith:
+		expr = fmt.Sprintf("strings.HasPrefix(fmt.Sprint(%s), fmt.Sprint(%s))", deref, param)
+	case OpEndingWith:
+		expr = fmt.Sprintf("strings.HasSuffix(fmt.Sprint(%s), fmt.Sprint(%s))", deref, param)
+	case OpContaining:
+		expr = fmt.Sprintf("strings.Contains(fmt.Sprint(%s), fmt.Sprint(%s))", deref, param)
+	default:
+		expr = fmt.Sprintf("compareValues(%s, %s) == 0", value, param)
+	}
+
+	if pointer {
+		return fmt.Sprintf("(%s != nil && %s)", value, expr)
+	}
+	return expr
+}
@@ -0,0 +1,418 @@
+package query
+
+import (
+	"fmt"
+	"go/token"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"gogen/pkg/models"
+)
+
+type Kind string
+
+const (
+	KindFind   Kind = "find"
+	KindExists Kind = "exists"
+	KindCount  Kind = "count"
+	KindDelete Kind = "delete"
+)
+
+type Operator string
+
+const (
+	OpEquals       Operator = ""
+	OpNot          Operator = "Not"
+	OpLessThan     Operator = "LessThan"
+	OpLessEqual    Operator = "LessThanEqual"
+	OpGreaterThan  Operator = "GreaterThan"
+	OpGreaterEqual Operator = "GreaterThanEqual"
+	OpBefore       Operator = "Before"
+	OpAfter        Operator = "After"
+	OpBetween      Operator = "Between"
+	OpLike         Operator = "Like"
+	OpNotLike      Operator = "NotLike"
+	OpStartingWith Operator = "StartingWith"
+	OpEndingWith   Operator = "EndingWith"
+	OpContaining   Operator = "Containing"
+	OpIsNull       Operator = "IsNull"
+	OpIsNotNull    Operator = "IsNotNull"
+	OpTrue         Operator = "True"
+	OpFalse        Operator = "False"
+)
+
+var operatorAliases = map[string]Operator{
+	"":                 OpEquals,
+	"Is":               OpEquals,
+	"Equals":           OpEquals,
+	"Not":              OpNot,
+	"IsNot":            OpNot,
+	"LessThan":         OpLessThan,
+	"LessThanEqual":    OpLessEqual,
+	"GreaterThan":      OpGreaterThan,
+	"GreaterThanEqual": OpGreaterEqual,
+	"Before":           OpBefore,
+	"After":            OpAfter,
+	"Between":          OpBetween,
+	"Like":             OpLike,
+	"NotLike":          OpNotLike,
+	"StartingWith":     OpStartingWith,
+	"StartsWith":       OpStartingWith,
+	"EndingWith":       OpEndingWith,
+	"EndsWith":         OpEndingWith,
+	"Containing":       OpContaining,
+	"Contains":         OpContaining,
+	"IsNull":           OpIsNull,
+	"Null":             OpIsNull,
+	"IsNotNull":        OpIsNotNull,
+	"NotNull":          OpIsNotNull,
+	"True":             OpTrue,
+	"IsTrue":           OpTrue,
+	"False":            OpFalse,
+	"IsFalse":          OpFalse,
+}
+
+var operatorKeywords = sortedKeywords()
+
+var methodPattern = regexp.MustCompile(`^(Find|Get|Read|Query|Search|Exists|Count|Delete|Remove)([A-Z][A-Za-z0-9]*?)?By([A-Z][A-Za-z0-9]*)$`)
+
+type Condition struct {
+	Field    models.Field
+	Operator Operator
+	Params   []string
+}
+
+type Order struct {
+	Field models.Field
+	Desc  bool
+}
+
+type Method struct {
+	Name    string
+	Kind    Kind
+	Many    bool
+	Groups  [][]Condition
+	OrderBy []Order
+
+	columns []models.Field
+}
+
+func IsDerived(name string) bool {
+	return methodPattern.MatchString(name)
+}
+
+func Parse(name string, columns []models.Field) (*Method, error) {
+	match := methodPattern.FindStringSubmatch(name)
+	if match == nil {
+		return nil, fmt.Errorf("%s is not a derived query name (expected Find|Exists|Count|Delete...By...)", name)
+	}
+
+	subject, predicate := match[2], match[3]
+	if startsWithWord(subject, "By") {
+		// The optional subject swallows "By<Predicate>Order" when the name has no subject
+		// and an OrderBy clause: FindByEmailOrderByAge.
+		predicate = subject[len("By"):] + "By" + predicate
+		subject = ""
+	}
+
+	method := &Method{Name: name, columns: columns}
+
+	switch match[1] {
+	case "Exists":
+		method.Kind = KindExists
+	case "Count":
+		method.Kind = KindCount
+	case "Delete", "Remove":
+		method.Kind = KindDelete
+	default:
+		method.Kind = KindFind
+		method.Many = subject != "" && subject != "First" && subject != "One"
+	}
+
+	fields := append([]models.Field(nil), columns...)
+	sort.SliceStable(fields, func(i, j int) bool {
+		return len(fields[i].Name) > len(fields[j].Name)
+	})
+
+	if idx := strings.LastIndex(predicate, "OrderBy"); idx > 0 {
+		orders, err := parseOrder(predicate[idx+len("OrderBy"):], fields)
+		if err != nil {
+			return nil, err
+		}
+		method.OrderBy = orders
+		predicate = predicate[:idx]
+	}
+
+	groups, ok := parseGroups(predicate, fields)
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q, available fields: %s",
+			unknownField(predicate, fields), fieldNames(columns))
+	}
+
+	for _, group := range groups {
+		for _, cond := range group {
+			if (cond.Operator == OpIsNull || cond.Operator == OpIsNotNull) && !cond.Field.IsNullable() {
+				return nil, fmt.Errorf("field %q is not nullable, nullable fields: %s",
+					cond.Field.Name, nullableFields(columns))
+			}
+		}
+	}
+
+	method.Groups = groups
+	method.assignParams()
+
+	return method, nil
+}
+
+func (m *Method) Params() []models.MethodParam {
+	var params []models.MethodParam
+	for _, group := range m.Groups {
+		for _, cond := range group {
+			for _, name := range cond.Params {
+				params = append(params, models.MethodParam{
+					Name: name,
+					Type: strings.TrimPrefix(cond.Field.Type, "*"),
+				})
+			}
+		}
+	}
+	return params
+}
+
+func (m *Method) Returns(entity string) []string {
+	switch m.Kind {
+	case KindExists:
+		return []string{"bool", "error"}
+	case KindCount:
+		return []string{"int64", "error"}
+	case KindDelete:
+		return []string{"int64", "error"}
+	}
+
+	if m.Many {
+		return []string{"[]*" + entity, "error"}
+	}
+	return []string{"*" + entity, "error"}
+}
+
+func (m *Method) Arity() int {
+	return len(m.Params())
+}
+
+func (m *Method) Rename(names []string) {
+	i := 0
+	for g := range m.Groups {
+		for c := range m.Groups[g] {
+			cond := &m.Groups[g][c]
+			for p := range cond.Params {
+				cond.Params[p] = names[i]
+				i++
+			}
+		}
+	}
+}
+
+func (m *Method) assignParams() {
+	used := make(map[string]int)
+
+	for g := range m.Groups {
+		for c := range m.Groups[g] {
+			cond := &m.Groups[g][c]
+
+			base := paramName(cond.Field.Name)
+			switch cond.Operator {
+			case OpIsNull, OpIsNotNull, OpTrue, OpFalse:
+				continue
+			case OpBetween:
+				cond.Params = []string{unique(base+"From", used), unique(base+"To", used)}
+			default:
+				cond.Params = []string{unique(base, used)}
+			}
+		}
+	}
+}
+
+func parseGroups(predicate string, columns []models.Field) ([][]Condition, bool) {
+	if predicate == "" {
+		return nil, false
+	}
+
+	for _, field := range columns {
+		if !strings.HasPrefix(predicate, field.Name) {
+			continue
+		}
+		rest := predicate[len(field.Name):]
+
+		for _, keyword := range operatorKeywords {
+			if !strings.HasPrefix(rest, keyword) {
+				continue
+			}
+			tail := rest[len(keyword):]
+			cond := Condition{Field: field, Operator: operatorAliases[keyword]}
+
+			switch {
+			case tail == "":
+				return [][]Condition{{cond}}, true
+			case startsWithWord(tail, "And"):
+				if groups, ok := parseGroups(tail[len("And"):], columns); ok {
+					groups[0] = append([]Condition{cond}, groups[0]...)
+					return groups, true
+				}
+			case startsWithWord(tail, "Or"):
+				if groups, ok := parseGroups(tail[len("Or"):], columns); ok {
+					return append([][]Condition{{cond}}, groups...), true
+				}
+			}
+		}
+	}
+
+	return nil, false
+}
+
+func parseOrder(clause string, columns []models.Field) ([]Order, error) {
+	var orders []Order
+
+	for clause != "" {
+		matched := false
+		for _, field := range columns {
+			if !strings.HasPrefix(clause, field.Name) {
+				continue
+			}
+
+			order := Order{Field: field}
+			rest := clause[len(field.Name):]
+			switch {
+			case strings.HasPrefix(rest, "Desc"):
+				order.Desc = true
+				rest = rest[len("Desc"):]
+			case strings.HasPrefix(rest, "Asc"):
+				rest = rest[len("Asc"):]
+			}
+
+			if rest != "" && !unicode.IsUpper(rune(rest[0])) {
+				continue
+			}
+
+			orders = append(orders, order)
+			clause = rest
+			matched = true
+			break
+		}
+
+		if !matched {
+			return nil, fmt.Errorf("unknown order field %q", clause)
+		}
+	}
+
+	if len(orders) == 0 {
+		return nil, fmt.Errorf("OrderBy requires a field")
+	}
+
+	return orders, nil
+}
+
+func sortedKeywords() []string {
+	keywords := make([]string, 0, len(operatorAliases))
+	for keyword := range operatorAliases {
+		keywords = append(keywords, keyword)
+	}
+	sort.Slice(keywords, func(i, j int) bool {
+		if len(keywords[i]) != len(keywords[j]) {
+			return len(keywords[i]) > len(keywords[j])
+		}
+		return keywords[i] < keywords[j]
+	})
+	return keywords
+}
+
+func startsWithWord(s, word string) bool {
+	return strings.HasPrefix(s, word) && len(s) > len(word) && unicode.IsUpper(rune(s[len(word)]))
+}
+
+func unknownField(predicate string, columns []models.Field) string {
+	for _, segment := range splitWords(predicate, "And", "Or") {
+		if !validSegment(segment, columns) {
+			return segment
+		}
+	}
+	return predicate
+}
+
+func validSegment(segment string, columns []models.Field) bool {
+	for _, field := range columns {
+		if !strings.HasPrefix(segment, field.Name) {
+			continue
+		}
+		if _, ok := operatorAliases[segment[len(field.Name):]]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func splitWords(s string, words ...string) []string {
+	var parts []string
+
+	start := 0
+	for i := 1; i < len(s); i++ {
+		for _, word := range words {
+			if startsWithWord(s[i:], word) {
+				parts = append(parts, s[start:i])
+				start = i + len(word)
+				i = start
+				break
+			}
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+func nullableFields(fields []models.Field) string {
+	var names []string
+	for _, field := range fields {
+		if field.IsNullable() {
+			names = append(names, field.Name)
+		}
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, ", ")
+}
+
+func fieldNames(fields []models.Field) string {
+	var names []string
+	for _, field := range fields {
+		names = append(names, field.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func paramName(field string) string {
+	runes := []rune(field)
+
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+	if upper > 1 && upper < len(runes) {
+		upper--
+	}
+
+	name := strings.ToLower(string(runes[:upper])) + string(runes[upper:])
+	if token.IsKeyword(name) {
+		name += "Value"
+	}
+	return name
+}
+
+func unique(name string, used map[string]int) string {
+	used[name]++
+	if used[name] == 1 {
+		return name
+	}
+	return fmt.Sprintf("%s%d", name, used[name])
+}
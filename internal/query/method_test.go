@@ -0,0 +1,222 @@
+package query
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"gogen/pkg/models"
+)
+
+var testColumns = []models.Field{
+	{Name: "ID", Type: "uuid.UUID", DBTag: "id"},
+	{Name: "OwnerID", Type: "uuid.UUID", DBTag: "owner_id"},
+	{Name: "Email", Type: "string", DBTag: "email"},
+	{Name: "EmailVerified", Type: "bool", DBTag: "email_verified"},
+	{Name: "Age", Type: "int", DBTag: "age"},
+	{Name: "Type", Type: "string", DBTag: "type"},
+	{Name: "DeletedAt", Type: "*time.Time", DBTag: "deleted_at"},
+	{Name: "CreatedAt", Type: "time.Time", DBTag: "created_at"},
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		kind    Kind
+		many    bool
+		groups  string
+		orderBy string
+		params  []models.MethodParam
+	}{
+		{
+			name:   "FindByEmail",
+			kind:   KindFind,
+			groups: "email",
+			params: []models.MethodParam{{Name: "email", Type: "string"}},
+		},
+		{
+			name:   "FindAllByOwnerID",
+			kind:   KindFind,
+			many:   true,
+			groups: "owner_id",
+			params: []models.MethodParam{{Name: "ownerID", Type: "uuid.UUID"}},
+		},
+		{
+			name:   "FindFirstByEmailVerifiedTrue",
+			kind:   KindFind,
+			groups: "email_verified True",
+		},
+		{
+			name:   "FindByEmailVerified",
+			kind:   KindFind,
+			groups: "email_verified",
+			params: []models.MethodParam{{Name: "emailVerified", Type: "bool"}},
+		},
+		{
+			name:   "CountByAgeBetween",
+			kind:   KindCount,
+			groups: "age Between",
+			params: []models.MethodParam{{Name: "ageFrom", Type: "int"}, {Name: "ageTo", Type: "int"}},
+		},
+		{
+			name:   "ExistsByEmailAndDeletedAtIsNull",
+			kind:   KindExists,
+			groups: "email, deleted_at IsNull",
+			params: []models.MethodParam{{Name: "email", Type: "string"}},
+		},
+		{
+			name:   "DeleteByDeletedAtBefore",
+			kind:   KindDelete,
+			groups: "deleted_at Before",
+			params: []models.MethodParam{{Name: "deletedAt", Type: "time.Time"}},
+		},
+		{
+			name:   "FindAllByAgeGreaterThanEqualOrAgeLessThan",
+			kind:   KindFind,
+			many:   true,
+			groups: "age GreaterThanEqual | age LessThan",
+			params: []models.MethodParam{{Name: "age", Type: "int"}, {Name: "age2", Type: "int"}},
+		},
+		{
+			name:    "FindByEmailOrderByAge",
+			kind:    KindFind,
+			groups:  "email",
+			orderBy: "age",
+			params:  []models.MethodParam{{Name: "email", Type: "string"}},
+		},
+		{
+			name:   "FindByType",
+			kind:   KindFind,
+			groups: "type",
+			params: []models.MethodParam{{Name: "typeValue", Type: "string"}},
+		},
+		{
+			name:    "FindAllByEmailContainingOrderByCreatedAtDescAge",
+			kind:    KindFind,
+			many:    true,
+			groups:  "email Containing",
+			orderBy: "created_at desc, age",
+			params:  []models.MethodParam{{Name: "email", Type: "string"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !IsDerived(tt.name) {
+				t.Fatalf("IsDerived(%q) = false", tt.name)
+			}
+
+			method, err := Parse(tt.name, testColumns)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if method.Kind != tt.kind {
+				t.Errorf("Kind = %q, want %q", method.Kind, tt.kind)
+			}
+			if method.Many != tt.many {
+				t.Errorf("Many = %v, want %v", method.Many, tt.many)
+			}
+			if got := formatGroups(method.Groups); got != tt.groups {
+				t.Errorf("Groups = %q, want %q", got, tt.groups)
+			}
+			if got := formatOrder(method.OrderBy); got != tt.orderBy {
+				t.Errorf("OrderBy = %q, want %q", got, tt.orderBy)
+			}
+			if got := method.Params(); !reflect.DeepEqual(got, tt.params) {
+				t.Errorf("Params() = %v, want %v", got, tt.params)
+			}
+			if method.Arity() != len(tt.params) {
+				t.Errorf("Arity() = %d, want %d", method.Arity(), len(tt.params))
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "FindEverything", want: "not a derived query"},
+		{name: "FindByNickname", want: `unknown field "Nickname"`},
+		{name: "FindByEmailAndNickname", want: `unknown field "Nickname"`},
+		{name: "FindByEmailOrderByNickname", want: `unknown order field "Nickname"`},
+		{name: "FindByEmailIsNull", want: `field "Email" is not nullable, nullable fields: DeletedAt`},
+		{name: "CountByAgeIsNotNull", want: `field "Age" is not nullable`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.name, testColumns)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Parse() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMethod_Returns(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{name: "FindByEmail", want: []string{"*User", "error"}},
+		{name: "FindOneByEmail", want: []string{"*User", "error"}},
+		{name: "FindAllByAge", want: []string{"[]*User", "error"}},
+		{name: "ExistsByEmail", want: []string{"bool", "error"}},
+		{name: "CountByAge", want: []string{"int64", "error"}},
+		{name: "RemoveByAge", want: []string{"int64", "error"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			method, err := Parse(tt.name, testColumns)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if got := method.Returns("User"); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Returns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMethod_Rename(t *testing.T) {
+	method, err := Parse("FindByEmailAndAgeBetween", testColumns)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	method.Rename([]string{"address", "min", "max"})
+
+	want := []models.MethodParam{{Name: "address", Type: "string"}, {Name: "min", Type: "int"}, {Name: "max", Type: "int"}}
+	if got := method.Params(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Params() = %v, want %v", got, want)
+	}
+}
+
+// formatGroups renders OR groups as "column Op, column | column".
+func formatGroups(groups [][]Condition) string {
+	var ors []string
+	for _, group := range groups {
+		var ands []string
+		for _, cond := range group {
+			ands = append(ands, strings.TrimSpace(cond.Field.DBTag+" "+string(cond.Operator)))
+		}
+		ors = append(ors, strings.Join(ands, ", "))
+	}
+	return strings.Join(ors, " | ")
+}
+
+func formatOrder(orders []Order) string {
+	var parts []string
+	for _, order := range orders {
+		part := order.Field.DBTag
+		if order.Desc {
+			part += " desc"
+		}
+		parts = append(parts, part)
+	}
+	return strings.Join(parts, ", ")
+}
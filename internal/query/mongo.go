@@ -0,0 +1,104 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+func (m *Method) MongoFilter(softDelete bool) string {
+	filter := m.mongoGroups()
+	if !softDelete {
+		return filter
+	}
+	return fmt.Sprintf(`bson.M{"$and": bson.A{%s, bson.M{"deleted_at": nil}}}`, filter)
+}
+
+func (m *Method) mongoGroups() string {
+	groups := make([]string, len(m.Groups))
+	for i, group := range m.Groups {
+		conditions := make([]string, len(group))
+		for j, cond := range group {
+			conditions[j] = fmt.Sprintf("bson.M{%q: %s}", mongoKey(cond), mongoValue(cond))
+		}
+
+		if len(conditions) == 1 {
+			groups[i] = conditions[0]
+		} else {
+			groups[i] = fmt.Sprintf(`bson.M{"$and": bson.A{%s}}`, strings.Join(conditions, ", "))
+		}
+	}
+
+	if len(groups) == 1 {
+		return groups[0]
+	}
+	return fmt.Sprintf(`bson.M{"$or": bson.A{%s}}`, strings.Join(groups, ", "))
+}
+
+func (m *Method) MongoSort() string {
+	if len(m.OrderBy) == 0 {
+		return ""
+	}
+
+	keys := make([]string, len(m.OrderBy))
+	for i, order := range m.OrderBy {
+		direction := 1
+		if order.Desc {
+			direction = -1
+		}
+		keys[i] = fmt.Sprintf("{Key: %q, Value: %d}", mongoKey(Condition{Field: order.Field}), direction)
+	}
+
+	return "bson.D{" + strings.Join(keys, ", ") + "}"
+}
+
+func mongoKey(cond Condition) string {
+	if cond.Field.DBTag == "id" {
+		return "_id"
+	}
+	return cond.Field.DBTag
+}
+
+func mongoValue(cond Condition) string {
+	var param, second string
+	if len(cond.Params) > 0 {
+		param = cond.Params[0]
+	}
+	if len(cond.Params) > 1 {
+		second = cond.Params[1]
+	}
+
+	switch cond.Operator {
+	case OpNot:
+		return fmt.Sprintf(`bson.M{"$ne": %s}`, param)
+	case OpLessThan, OpBefore:
+		return fmt.Sprintf(`bson.M{"$lt": %s}`, param)
+	case OpLessEqual:
+		return fmt.Sprintf(`bson.M{"$lte": %s}`, param)
+	case OpGreaterThan, OpAfter:
+		return fmt.Sprintf(`bson.M{"$gt": %s}`, param)
+	case OpGreaterEqual:
+		return fmt.Sprintf(`bson.M{"$gte": %s}`, param)
+	case OpBetween:
+		return fmt.Sprintf(`bson.M{"$gte": %s, "$lte": %s}`, param, second)
+	case OpLike:
+		return fmt.Sprintf(`bson.M{"$regex": %s}`, param)
+	case OpNotLike:
+		return fmt.Sprintf(`bson.M{"$not": bson.M{"$regex": %s}}`, param)
+	case OpStartingWith:
+		return fmt.Sprintf(`bson.M{"$regex": "^" + regexp.QuoteMeta(%s)}`, param)
+	case OpEndingWith:
+		return fmt.Sprintf(`bson.M{"$regex": regexp.QuoteMeta(%s) + "$"}`, param)
+	case OpContaining:
+		return fmt.Sprintf(`bson.M{"$regex": regexp.QuoteMeta(%s)}`, param)
+	case OpIsNull:
+		return "nil"
+	case OpIsNotNull:
+		return `bson.M{"$ne": nil}`
+	case OpTrue:
+		return "true"
+	case OpFalse:
+		return "false"
+	default:
+		return param
+	}
+}
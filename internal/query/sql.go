@@ -0,0 +1,140 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	"gogen/internal/dialect"
+	"gogen/pkg/models"
+)
+
+const clauseSeparator = "\n\t\t"
+
+func (m *Method) SQL(d dialect.Dialect, schema models.Schema) string {
+	table := schema.Table
+	where := "WHERE " + m.where(d, schema.SoftDelete)
+
+	switch m.Kind {
+	case KindExists:
+		return fmt.Sprintf("SELECT EXISTS (SELECT 1 FROM %s %s)", table, where)
+	case KindCount:
+		return fmt.Sprintf("SELECT COUNT(*) FROM %s%s%s", table, clauseSeparator, where)
+	case KindDelete:
+		if schema.SoftDelete {
+			return fmt.Sprintf("UPDATE %s SET deleted_at = CURRENT_TIMESTAMP%s%s", table, clauseSeparator, where)
+		}
+		return fmt.Sprintf("DELETE FROM %s%s%s", table, clauseSeparator, where)
+	}
+
+	clauses := []string{
+		"SELECT " + strings.Join(dialect.Columns(m.columns), ", "),
+		"FROM " + table,
+		where,
+	}
+
+	if len(m.OrderBy) > 0 {
+		orders := make([]string, len(m.OrderBy))
+		for i, order := range m.OrderBy {
+			orders[i] = order.Field.DBTag
+			if order.Desc {
+				orders[i] += " DESC"
+			}
+		}
+		clauses = append(clauses, "ORDER BY "+strings.Join(orders, ", "))
+	}
+
+	if !m.Many {
+		clauses = append(clauses, "LIMIT 1")
+	}
+
+	return strings.Join(clauses, clauseSeparator)
+}
+
+func (m *Method) Args() []string {
+	var args []string
+	for _, group := range m.Groups {
+		for _, cond := range group {
+			for _, param := range cond.Params {
+				args = append(args, argument(cond.Operator, param))
+			}
+		}
+	}
+	return args
+}
+
+func (m *Method) where(d dialect.Dialect, softDelete bool) string {
+	n := 0
+	next := func() string {
+		n++
+		return d.Placeholder(n)
+	}
+
+	groups := make([]string, len(m.Groups))
+	for i, group := range m.Groups {
+		conditions := make([]string, len(group))
+		for j, cond := range group {
+			conditions[j] = sqlCondition(cond, next)
+		}
+
+		groups[i] = strings.Join(conditions, " AND ")
+		if len(m.Groups) > 1 && len(group) > 1 {
+			groups[i] = "(" + groups[i] + ")"
+		}
+	}
+
+	where := strings.Join(groups, " OR ")
+	if !softDelete {
+		return where
+	}
+	if len(groups) > 1 {
+		where = "(" + where + ")"
+	}
+	return where + " AND " + dialect.NotDeleted
+}
+
+func sqlCondition(cond Condition, next func() string) string {
+	column := cond.Field.DBTag
+
+	switch cond.Operator {
+	case OpNot:
+		return fmt.Sprintf("%s <> %s", column, next())
+	case OpLessThan, OpBefore:
+		return fmt.Sprintf("%s < %s", column, next())
+	case OpLessEqual:
+		return fmt.Sprintf("%s <= %s", column, next())
+	case OpGreaterThan, OpAfter:
+		return fmt.Sprintf("%s > %s", column, next())
+	case OpGreaterEqual:
+		return fmt.Sprintf("%s >= %s", column, next())
+	case OpBetween:
+		from := next()
+		return fmt.Sprintf("%s BETWEEN %s AND %s", column, from, next())
+	case OpLike, OpStartingWith, OpEndingWith, OpContaining:
+		return fmt.Sprintf("%s LIKE %s", column, next())
+	case OpNotLike:
+		return fmt.Sprintf("%s NOT LIKE %s", column, next())
+	case OpIsNull:
+		return column + " IS NULL"
+	case OpIsNotNull:
+		return column + " IS NOT NULL"
+	case OpTrue:
+		return column + " = TRUE"
+	case OpFalse:
+		return column + " = FALSE"
+	default:
+		return fmt.Sprintf("%s = %s", column, next())
+	}
+}
+
+func argument(op Operator, param string) string {
+	switch op {
+	case OpStartingWith:
+		return param + ` + "%"`
+	case OpEndingWith:
+		return `"%" + ` + param
+	case OpContaining:
+		return `"%" + ` + param + ` + "%"`
+	default:
+		return param
+	}
+}
@@ -0,0 +1,269 @@
+package spec
+
+import (
+	"fmt"
+	"strings"
+
+	"gogen/internal/dialect"
+	"gogen/internal/parser"
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+type Builder struct {
+	fieldParser  *parser.FieldParser
+	methodParser *parser.MethodParser
+}
+
+func NewBuilder() *Builder {
+	return &Builder{
+		fieldParser:  parser.NewFieldParser(),
+		methodParser: parser.NewMethodParser(),
+	}
+}
+
+func (b *Builder) BuildPlan(s *Spec) (*models.GenerationPlan, error) {
+	if err := Validate(s); err != nil {
+		return nil, err
+	}
+
+	plan := &models.GenerationPlan{
+		WithTests: s.WithTests,
+		WithMocks: s.WithMocks,
+	}
+
+	for _, es := range s.Entities {
+		entity, err := b.buildEntity(es)
+		if err != nil {
+			return nil, fmt.Errorf("entity %s: %w", es.Name, err)
+		}
+		plan.Entities = append(plan.Entities, entity)
+	}
+
+	for _, rs := range s.Repositories {
+		repo, err := b.buildRepository(rs, s.DB, plan)
+		if err != nil {
+			return nil, fmt.Errorf("repository %s: %w", rs.Name, err)
+		}
+		plan.Repositories = append(plan.Repositories, repo)
+	}
+
+	for _, us := range s.UseCases {
+		uc, err := b.buildUseCase(us, plan)
+		if err != nil {
+			return nil, fmt.Errorf("usecase %s: %w", us.Name, err)
+		}
+		plan.UseCases = append(plan.UseCases, uc)
+	}
+
+	for _, hs := range s.Handlers {
+		plan.Handlers = append(plan.Handlers, b.buildHandler(hs))
+	}
+
+	return plan, nil
+}
+
+func (b *Builder) buildEntity(es EntitySpec) (models.EntityConfig, error) {
+	entity := models.EntityConfig{
+		Name:          es.Name,
+		TableName:     es.Table,
+		AddValidation: boolOr(es.Validation, true),
+		AddComments:   boolOr(es.Comments, true),
+		JSONStyle:     es.JSONStyle,
+		IDStrategy:    models.IDStrategy(es.IDStrategy),
+		Key:           es.Key,
+		NoTimestamps:  !boolOr(es.Timestamps, true),
+		SoftDelete:    es.SoftDelete,
+		Audit:         es.Audit,
+		Versioned:     es.Versioned,
+	}
+
+	if entity.TableName == "" {
+		entity.TableName = util.ToSnakeCase(util.Pluralize(es.Name))
+	}
+	if entity.JSONStyle == "" {
+		entity.JSONStyle = "snake_case"
+	}
+
+	fields, err := b.buildFields(es.Fields)
+	if err != nil {
+		return models.EntityConfig{}, err
+	}
+	entity.Fields = fields
+
+	return entity, nil
+}
+
+func (b *Builder) buildFields(specs []FieldSpec) ([]models.Field, error) {
+	fields := make([]models.Field, 0, len(specs))
+
+	for _, fs := range specs {
+		field, err := b.buildField(fs)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+
+	return fields, nil
+}
+
+func (b *Builder) buildField(fs FieldSpec) (models.Field, error) {
+	if fs.DSL != "" {
+		return b.fieldParser.ParseField(fs.DSL)
+	}
+
+	tags := fs.Tags
+	if fs.Relation != "" {
+		tags = append(tags, fs.Relation)
+	}
+
+	typeName := fs.Type
+	if len(fs.Enum) > 0 {
+		if typeName == "" {
+			typeName = "enum"
+		}
+		typeName += "(" + strings.Join(fs.Enum, "|") + ")"
+	}
+
+	dsl := fs.Name + ":" + typeName
+	if len(tags) > 0 {
+		dsl += ":" + strings.Join(tags, ",")
+	}
+
+	field, err := b.fieldParser.ParseField(dsl)
+	if err != nil {
+		return models.Field{}, err
+	}
+
+	field.Required = field.Required || fs.Required
+	field.Unique = field.Unique || fs.Unique
+	field.Index = field.Index || fs.Index
+	field.Comment = fs.Comment
+
+	if fs.JSON != "" {
+		field.JSONTag = fs.JSON
+	}
+	if fs.DB != "" {
+		field.DBTag = fs.DB
+	}
+
+	return field, nil
+}
+
+func (b *Builder) buildRepository(rs RepositorySpec, defaultDB string, plan *models.GenerationPlan) (models.RepositoryConfig, error) {
+	name := strings.TrimSuffix(rs.Name, "Repository")
+
+	repo := models.RepositoryConfig{
+		Name:             name,
+		Entity:           rs.Entity,
+		TableName:        rs.Table,
+		DBType:           dialect.Normalize(rs.DB),
+		WithTransactions: boolOr(rs.Transactions, true),
+		AddComments:      boolOr(rs.Comments, true),
+		Versioned:        rs.Versioned,
+	}
+
+	if repo.Entity == "" {
+		repo.Entity = name
+	}
+	if rs.DB == "" {
+		repo.DBType = dialect.Normalize(defaultDB)
+	}
+	if repo.TableName == "" {
+		if entity := plan.GetEntityByName(repo.Entity); entity != nil {
+			repo.TableName = entity.TableName
+		}
+	}
+
+	for _, ms := range rs.Methods {
+		method, err := b.methodParser.Parse(methodSignature(ms))
+		if err != nil {
+			return models.RepositoryConfig{}, fmt.Errorf("method %s: %w", ms.Name, err)
+		}
+		method.Comment = ms.Comment
+
+		repo.CustomMethods = append(repo.CustomMethods, method)
+	}
+
+	return repo, nil
+}
+
+func methodSignature(ms MethodSpec) string {
+	params := make([]string, len(ms.Params))
+	for i, ps := range ms.Params {
+		params[i] = ps.Name + " " + ps.Type
+	}
+
+	signature := ms.Name + "(" + strings.Join(params, ", ") + ")"
+	if len(ms.Returns) > 0 {
+		signature += " (" + strings.Join(ms.Returns, ", ") + ")"
+	}
+	return signature
+}
+
+func (b *Builder) buildUseCase(us UseCaseSpec, plan *models.GenerationPlan) (models.UseCaseConfig, error) {
+	name := strings.TrimSuffix(us.Name, "UseCase")
+
+	uc := models.UseCaseConfig{
+		Name:          name,
+		Description:   us.Description,
+		Dependencies:  []models.Dependency{},
+		WithLogging:   us.Logging,
+		WithMetrics:   us.Metrics,
+		Transactional: us.Transactional,
+		AddComments:   boolOr(us.Comments, true),
+		Example:       us.Example,
+	}
+
+	if uc.Description == "" {
+		uc.Description = fmt.Sprintf("операцию %s", name)
+	}
+
+	for _, dep := range us.Dependencies {
+		uc.Dependencies = append(uc.Dependencies, models.Dependency{
+			Name:  strings.TrimSuffix(dep, "Repository") + "Repository",
+			Type:  "repository",
+			Found: plan.HasRepository(strings.TrimSuffix(dep, "Repository")),
+		})
+	}
+
+	input, err := b.buildFields(us.Input)
+	if err != nil {
+		return models.UseCaseConfig{}, fmt.Errorf("input: %w", err)
+	}
+	uc.InputFields = input
+
+	output, err := b.buildFields(us.Output)
+	if err != nil {
+		return models.UseCaseConfig{}, fmt.Errorf("output: %w", err)
+	}
+	uc.OutputFields = output
+
+	return uc, nil
+}
+
+func (b *Builder) buildHandler(hs HandlerSpec) models.HandlerConfig {
+	name := strings.TrimSuffix(hs.Name, "Handler")
+
+	handler := models.HandlerConfig{
+		Name:        name,
+		UseCase:     hs.UseCase,
+		Route:       hs.Route,
+		Method:      hs.Method,
+		AddComments: boolOr(hs.Comments, true),
+	}
+
+	if handler.Route == "" {
+		handler.Route = util.ToRoute(util.Pluralize(name))
+	}
+
+	return handler
+}
+
+func boolOr(value *bool, fallback bool) bool {
+	if value == nil {
+		return fallback
+	}
+	return *value
+}
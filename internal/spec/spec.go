@@ -0,0 +1,128 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+const DefaultFileName = "gogen.spec.yaml"
+
+type Spec struct {
+	Version      string           `yaml:"version" json:"version"`
+	DB           string           `yaml:"db" json:"db"`
+	WithTests    bool             `yaml:"with_tests" json:"with_tests"`
+	WithMocks    bool             `yaml:"with_mocks" json:"with_mocks"`
+	Entities     []EntitySpec     `yaml:"entities" json:"entities"`
+	Repositories []RepositorySpec `yaml:"repositories" json:"repositories"`
+	UseCases     []UseCaseSpec    `yaml:"usecases" json:"usecases"`
+	Handlers     []HandlerSpec    `yaml:"handlers" json:"handlers"`
+}
+
+type EntitySpec struct {
+	Name       string      `yaml:"name" json:"name"`
+	Table      string      `yaml:"table" json:"table"`
+	Fields     []FieldSpec `yaml:"fields" json:"fields"`
+	Validation *bool       `yaml:"validation" json:"validation"`
+	Comments   *bool       `yaml:"comments" json:"comments"`
+	JSONStyle  string      `yaml:"json_style" json:"json_style"`
+	IDStrategy string      `yaml:"id_strategy" json:"id_strategy"`
+	Key        []string    `yaml:"key" json:"key"`
+	Timestamps *bool       `yaml:"timestamps" json:"timestamps"`
+	SoftDelete bool        `yaml:"soft_delete" json:"soft_delete"`
+	Audit      bool        `yaml:"audit" json:"audit"`
+	Versioned  bool        `yaml:"versioned" json:"versioned"`
+}
+
+type FieldSpec struct {
+	DSL      string   `yaml:"-" json:"-"`
+	Name     string   `yaml:"name" json:"name"`
+	Type     string   `yaml:"type" json:"type"`
+	Tags     []string `yaml:"tags" json:"tags"`
+	JSON     string   `yaml:"json" json:"json"`
+	DB       string   `yaml:"db" json:"db"`
+	Comment  string   `yaml:"comment" json:"comment"`
+	Required bool     `yaml:"required" json:"required"`
+	Unique   bool     `yaml:"unique" json:"unique"`
+	Index    bool     `yaml:"index" json:"index"`
+	Relation string   `yaml:"relation" json:"relation"`
+	Enum     []string `yaml:"enum" json:"enum"`
+}
+
+type RepositorySpec struct {
+	Name         string       `yaml:"name" json:"name"`
+	Entity       string       `yaml:"entity" json:"entity"`
+	Table        string       `yaml:"table" json:"table"`
+	DB           string       `yaml:"db" json:"db"`
+	Transactions *bool        `yaml:"transactions" json:"transactions"`
+	Comments     *bool        `yaml:"comments" json:"comments"`
+	Versioned    bool         `yaml:"versioned" json:"versioned"`
+	Methods      []MethodSpec `yaml:"methods" json:"methods"`
+}
+
+type MethodSpec struct {
+	Name    string      `yaml:"name" json:"name"`
+	Comment string      `yaml:"comment" json:"comment"`
+	Params  []ParamSpec `yaml:"params" json:"params"`
+	Returns []string    `yaml:"returns" json:"returns"`
+}
+
+type ParamSpec struct {
+	Name string `yaml:"name" json:"name"`
+	Type string `yaml:"type" json:"type"`
+}
+
+type UseCaseSpec struct {
+	Name          string      `yaml:"name" json:"name"`
+	Description   string      `yaml:"description" json:"description"`
+	Dependencies  []string    `yaml:"dependencies" json:"dependencies"`
+	Input         []FieldSpec `yaml:"input" json:"input"`
+	Output        []FieldSpec `yaml:"output" json:"output"`
+	Logging       bool        `yaml:"logging" json:"logging"`
+	Metrics       bool        `yaml:"metrics" json:"metrics"`
+	Transactional bool        `yaml:"transactional" json:"transactional"`
+	Comments      *bool       `yaml:"comments" json:"comments"`
+	Example       string      `yaml:"example" json:"example"`
+}
+
+type HandlerSpec struct {
+	Name     string `yaml:"name" json:"name"`
+	UseCase  string `yaml:"usecase" json:"usecase"`
+	Route    string `yaml:"route" json:"route"`
+	Method   string `yaml:"method" json:"method"`
+	Comments *bool  `yaml:"comments" json:"comments"`
+}
+
+type fieldSpecFields FieldSpec
+
+func (f *FieldSpec) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		f.DSL = node.Value
+		return nil
+	}
+
+	var fields fieldSpecFields
+	if err := node.Decode(&fields); err != nil {
+		return err
+	}
+
+	*f = FieldSpec(fields)
+	return nil
+}
+
+func (f *FieldSpec) UnmarshalJSON(data []byte) error {
+	var dsl string
+	if err := json.Unmarshal(data, &dsl); err == nil {
+		f.DSL = dsl
+		return nil
+	}
+
+	var fields fieldSpecFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return fmt.Errorf("field must be a string or an object: %w", err)
+	}
+
+	*f = FieldSpec(fields)
+	return nil
+}
@@ -0,0 +1,176 @@
+package spec
+
+import (
+	"fmt"
+	"strings"
+
+	"gogen/internal/dialect"
+	"gogen/internal/parser"
+	"gogen/internal/util"
+	"gogen/pkg/models"
+)
+
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid spec:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+func Validate(s *Spec) error {
+	var problems []string
+	report := func(format string, args ...interface{}) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if len(s.Entities) == 0 && len(s.Repositories) == 0 &&
+		len(s.UseCases) == 0 && len(s.Handlers) == 0 {
+		report("spec does not declare any components")
+	}
+
+	if s.DB != "" && !isKnownDB(s.DB) {
+		report("unsupported db: %s", s.DB)
+	}
+
+	entities := make(map[string]bool)
+	for i, entity := range s.Entities {
+		if err := util.ValidatePascalCase(entity.Name); err != nil {
+			report("entities[%d]: %v", i, err)
+			continue
+		}
+		if entities[entity.Name] {
+			report("entity %s is declared twice", entity.Name)
+		}
+		entities[entity.Name] = true
+
+		if _, ok := models.ParseIDStrategy(entity.IDStrategy); !ok {
+			report("entity %s: unsupported id_strategy: %s", entity.Name, entity.IDStrategy)
+		}
+
+		validateFields(report, "entity "+entity.Name, entity.Fields)
+	}
+
+	repositories := make(map[string]bool)
+	for i, repo := range s.Repositories {
+		name := strings.TrimSuffix(repo.Name, "Repository")
+		if err := util.ValidatePascalCase(name); err != nil {
+			report("repositories[%d]: %v", i, err)
+			continue
+		}
+		if repositories[name] {
+			report("repository %s is declared twice", name)
+		}
+		repositories[name] = true
+
+		entity := repo.Entity
+		if entity == "" {
+			entity = name
+		}
+		if !entities[entity] {
+			report("repository %s refers to undeclared entity %s", name, entity)
+		}
+
+		if repo.DB != "" && !isKnownDB(repo.DB) {
+			report("repository %s: unsupported db: %s", name, repo.DB)
+		}
+
+		for _, method := range repo.Methods {
+			if err := util.ValidatePascalCase(method.Name); err != nil {
+				report("repository %s: method %v", name, err)
+				continue
+			}
+			for _, param := range method.Params {
+				if err := util.ValidateIdentifier(param.Name); err != nil {
+					report("repository %s: method %s: %v", name, method.Name, err)
+				}
+			}
+
+			parsed, err := parser.NewMethodParser().Parse(methodSignature(method))
+			if err != nil {
+				report("repository %s: method %s: %v", name, method.Name, err)
+				continue
+			}
+			for _, param := range parsed.Params {
+				if err := util.ValidateType(strings.TrimPrefix(param.Type, "...")); err != nil {
+					report("repository %s: method %s: %v", name, method.Name, err)
+				}
+			}
+			for _, result := range parsed.Returns {
+				if err := util.ValidateType(result); err != nil {
+					report("repository %s: method %s: %v", name, method.Name, err)
+				}
+			}
+		}
+	}
+
+	usecases := make(map[string]bool)
+	for i, uc := range s.UseCases {
+		name := strings.TrimSuffix(uc.Name, "UseCase")
+		if err := util.ValidatePascalCase(name); err != nil {
+			report("usecases[%d]: %v", i, err)
+			continue
+		}
+		if usecases[name] {
+			report("usecase %s is declared twice", name)
+		}
+		usecases[name] = true
+
+		for _, dep := range uc.Dependencies {
+			if !repositories[strings.TrimSuffix(dep, "Repository")] {
+				report("usecase %s depends on undeclared repository %s", name, dep)
+			}
+		}
+
+		validateFields(report, "usecase "+name+" input", uc.Input)
+		validateFields(report, "usecase "+name+" output", uc.Output)
+	}
+
+	for i, handler := range s.Handlers {
+		name := strings.TrimSuffix(handler.Name, "Handler")
+		if err := util.ValidatePascalCase(name); err != nil {
+			report("handlers[%d]: %v", i, err)
+			continue
+		}
+		if handler.UseCase != "" && !usecases[strings.TrimSuffix(handler.UseCase, "UseCase")] {
+			report("handler %s refers to undeclared usecase %s", name, handler.UseCase)
+		}
+		if handler.Route != "" && !strings.HasPrefix(handler.Route, "/") {
+			report("handler %s: route must start with /", name)
+		}
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+
+	return nil
+}
+
+func validateFields(report func(string, ...interface{}), owner string, fields []FieldSpec) {
+	seen := make(map[string]bool)
+
+	for i, field := range fields {
+		name := field.Name
+		if field.DSL != "" {
+			name = strings.TrimSpace(strings.SplitN(field.DSL, ":", 2)[0])
+		} else if len(field.Enum) == 0 {
+			if err := util.ValidateType(field.Type); err != nil {
+				report("%s: fields[%d]: %v", owner, i, err)
+			}
+		}
+
+		if err := util.ValidatePascalCase(name); err != nil {
+			report("%s: fields[%d]: %v", owner, i, err)
+			continue
+		}
+		if seen[name] {
+			report("%s: field %s is declared twice", owner, name)
+		}
+		seen[name] = true
+	}
+}
+
+func isKnownDB(db string) bool {
+	return dialect.IsSQL(db) || dialect.Normalize(db) == dialect.MongoDB
+}
@@ -0,0 +1,34 @@
+package spec
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		field FieldSpec
+		want  string
+	}{
+		{name: "enum without type", field: FieldSpec{Name: "Status", Enum: []string{"new", "paid"}}},
+		{name: "named enum type", field: FieldSpec{Name: "Status", Type: "OrderStatus", Enum: []string{"new", "paid"}}},
+		{name: "plain type", field: FieldSpec{Name: "Total", Type: "int64"}},
+		{name: "missing type", field: FieldSpec{Name: "Total"}, want: "type cannot be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(&Spec{
+				Entities: []EntitySpec{{Name: "Order", Fields: []FieldSpec{tt.field}}},
+			})
+
+			switch {
+			case tt.want == "" && err != nil:
+				t.Errorf("Validate() error = %v, want nil", err)
+			case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+				t.Errorf("Validate() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package template
 
 import (
+	"gogen/internal/dialect"
 	"gogen/pkg/models"
 )
 
@@ -12,6 +13,103 @@ type EntityData struct {
 	AddComments   bool
 	AddValidation bool
 	JSONStyle     string
+	BSON          bool
+	Relations     []models.Relation
+	Enums         []EnumData
+	Checks        []ValidationCheck
+	Patterns      []ValidationPattern
+	ValidateTags  bool
+	ValidateAll   bool
+	Key           KeyData
+	Tracking      []models.Field
+	Timestamps    bool
+}
+
+type KeyData struct {
+	Strategy      string
+	Type          string
+	Generate      string
+	Composite     bool
+	AutoIncrement bool
+	Fields        []models.Field
+	Params        []MethodParam
+}
+
+type ValidationCheck struct {
+	Field     string
+	Rule      string
+	Condition string
+	Message   string
+}
+
+type ValidationPattern struct {
+	Name string
+	Expr string
+}
+
+type ValidationData struct {
+	Tags        bool
+	AddComments bool
+}
+
+type AuditData struct {
+	AddComments bool
+}
+
+type ErrorsData struct {
+	AddComments bool
+}
+
+type PaginationData struct {
+	AddComments bool
+}
+
+type InMemorySupportData struct {
+	ModulePath    string
+	ErrorHandling string
+	AddComments   bool
+}
+
+type UseCaseErrorsData struct {
+	ModulePath    string
+	ErrorHandling string
+	AddComments   bool
+}
+
+type RepositoryErrorsData struct {
+	ModulePath    string
+	ErrorHandling string
+	Postgres      bool
+	MySQL         bool
+	SQLite        bool
+	MongoDB       bool
+	AddComments   bool
+}
+
+type InMemoryData struct {
+	RepositoryData
+	Lookup     string
+	Unique     []models.Field
+	UniqueKeys [][]models.Field
+	Preserved  []models.Field
+}
+
+type TransactionData struct {
+	ModulePath  string
+	SQL         bool
+	MongoDB     bool
+	AddComments bool
+}
+
+type EnumData struct {
+	Name     string
+	Values   []EnumValue
+	Optional bool
+}
+
+type EnumValue struct {
+	Name  string
+	Value string
 }
 
 type RepositoryData struct {
@@ -20,35 +118,117 @@ type RepositoryData struct {
 	TableName        string
 	ModulePath       string
 	DBType           string
+	Queries          dialect.Queries
 	CustomMethods    []CustomMethod
 	WithTransactions bool
 	AddComments      bool
 	Fields           []models.Field
+	Key              KeyData
+	Columns          []models.Field
+	InsertFields     []models.Field
+	UpdateFields     []models.Field
+	SoftDelete       bool
+	Audit            bool
+	Timestamps       bool
+	Versioned        bool
+	List             ListData
+	Samples          []FieldSample
+}
+
+type ListData struct {
+	SortFields  []ListField
+	DefaultSort ListField
+	Filters     []ListField
+	Cursor      []ListField
+	Nullable    []ListField
+}
+
+type ListField struct {
+	Name     string
+	Column   string
+	Type     string
+	Nullable bool
 }
 
 type CustomMethod struct {
-	Name    string
-	Comment string
-	Params  []MethodParam
-	Return  string
+	Name     string
+	Comment  string
+	Params   []MethodParam
+	Return   string
+	Body     string
+	Query    *DerivedQuery
+	Relation *RelationQuery
+}
+
+type DerivedQuery struct {
+	Kind   string
+	Many   bool
+	SQL    string
+	Args   []string
+	Filter string
+	Sort   string
+	Match  string
+	Order  []DerivedOrder
+}
+
+type DerivedOrder struct {
+	Field string
+	Desc  bool
+}
+
+type RelationQuery struct {
+	Kind     string
+	Relation models.Relation
+	Queries  dialect.RelationQueries
+	IDs      string
+}
+
+type FieldSample struct {
+	Name  string
+	Value string
 }
 
 type MethodParam struct {
-	Name string
-	Type string
+	Name   string
+	Type   string
+	Sample string
 }
 
 type UseCaseData struct {
-	Name         string
-	Description  string
-	ModulePath   string
-	Dependencies []Dependency
-	InputFields  []models.Field
-	OutputFields []models.Field
-	WithLogging  bool
-	WithMetrics  bool
-	AddComments  bool
-	Example      string
+	Name          string
+	Description   string
+	ModulePath    string
+	Dependencies  []Dependency
+	InputFields   []models.Field
+	OutputFields  []models.Field
+	WithLogging   bool
+	WithMetrics   bool
+	WithTracing   bool
+	Transactional bool
+	CRUD          *CRUDData
+	AddComments   bool
+	Example       string
+}
+
+type CRUDData struct {
+	Action         string
+	Entity         string
+	Fields         []models.Field
+	IDStrategy     string
+	Versioned      bool
+	Timestamps     bool
+	SeedID         string
+	MissingID      string
+	Samples        []FieldSample
+	InMemoryImport string
+}
+
+type ObservabilityData struct {
+	TracerName  string
+	Logging     bool
+	Metrics     bool
+	Tracing     bool
+	AddComments bool
 }
 
 type Dependency struct {
@@ -56,11 +236,41 @@ type Dependency struct {
 	Found bool
 }
 
+type HandlerData struct {
+	Name           string
+	Package        string
+	ModulePath     string
+	UseCaseImport  string
+	UseCasePackage string
+	Route          string
+	Endpoints      []HandlerEndpoint
+	AddComments    bool
+}
+
+type HandlerEndpoint struct {
+	Name        string
+	UseCase     string
+	Field       string
+	Method      string
+	Path        string
+	Status      string
+	HasBody     bool
+	PathID      *models.Field
+	QueryFields []models.Field
+}
+
+type HandlerResponseData struct {
+	Package     string
+	ModulePath  string
+	AddComments bool
+}
+
 type MockData struct {
-	Name       string
-	Entity     string
-	ModulePath string
-	Methods    []MockMethod
+	Name        string
+	Entity      string
+	ModulePath  string
+	Methods     []MockMethod
+	AddComments bool
 }
 
 type MockMethod struct {
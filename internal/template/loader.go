@@ -4,12 +4,14 @@ import (
 	"embed"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
 	"text/template"
 
 	"gogen/pkg/models"
 )
 
+//go:embed templates/*.tmpl
 var templatesFS embed.FS
 
 type Loader struct {
@@ -53,6 +55,19 @@ func (l *Loader) Load(templateName string) (*template.Template, error) {
 	return tmpl, nil
 }
 
+func (l *Loader) Source(templateName string) ([]byte, error) {
+	templatePath := l.getTemplatePath(templateName)
+
+	if l.isCustomTemplate(templatePath) {
+		if !filepath.IsAbs(templatePath) {
+			templatePath = filepath.Join(l.projectRoot, templatePath)
+		}
+		return os.ReadFile(templatePath)
+	}
+
+	return readEmbedded(templatePath)
+}
+
 func (l *Loader) getTemplatePath(name string) string {
 	switch name {
 	case "entity":
@@ -61,10 +76,40 @@ func (l *Loader) getTemplatePath(name string) string {
 		return l.config.Templates.RepositoryInterface
 	case "repository_impl":
 		return l.config.Templates.RepositoryImpl
+	case "repository_impl_mongodb":
+		return l.config.Templates.RepositoryImplMongo
 	case "usecase":
 		return l.config.Templates.UseCase
 	case "handler":
 		return l.config.Templates.Handler
+	case "handler_response":
+		return l.config.Templates.HandlerResponse
+	case "validation":
+		return l.config.Templates.Validation
+	case "audit":
+		return l.config.Templates.Audit
+	case "errors":
+		return l.config.Templates.Errors
+	case "repository_inmemory":
+		return l.config.Templates.RepositoryInMemory
+	case "inmemory":
+		return l.config.Templates.InMemory
+	case "test_inmemory":
+		return l.config.Templates.TestInMemory
+	case "repository_errors":
+		return l.config.Templates.RepositoryErrors
+	case "usecase_errors":
+		return l.config.Templates.UseCaseErrors
+	case "pagination":
+		return l.config.Templates.Pagination
+	case "transaction":
+		return l.config.Templates.Transaction
+	case "tx_manager":
+		return l.config.Templates.TxManager
+	case "tx_manager_mock":
+		return l.config.Templates.TxManagerMock
+	case "observability":
+		return l.config.Templates.Observability
 	case "mock":
 		return l.config.Templates.Mock
 	case "test_entity":
@@ -111,13 +156,13 @@ func (l *Loader) loadFromFile(path string) (*template.Template, error) {
 	return tmpl, nil
 }
 
-func (l *Loader) loadFromEmbed(path string) (*template.Template, error) {
-	data, err := templatesFS.ReadFile(path)
+func (l *Loader) loadFromEmbed(name string) (*template.Template, error) {
+	data, err := readEmbedded(name)
 	if err != nil {
 		return nil, err
 	}
 
-	tmpl, err := template.New(filepath.Base(path)).
+	tmpl, err := template.New(filepath.Base(name)).
 		Funcs(l.getFuncMap()).
 		Parse(string(data))
 
@@ -128,6 +173,10 @@ func (l *Loader) loadFromEmbed(path string) (*template.Template, error) {
 	return tmpl, nil
 }
 
+func readEmbedded(name string) ([]byte, error) {
+	return templatesFS.ReadFile(path.Join("templates", name))
+}
+
 func (l *Loader) ClearCache() {
 	l.cache = make(map[string]*template.Template)
 }
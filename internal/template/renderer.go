@@ -30,6 +30,10 @@ func (r *Renderer) Render(templateName string, data interface{}) (string, error)
 	return buf.String(), nil
 }
 
+func (r *Renderer) Source(templateName string) ([]byte, error) {
+	return r.loader.Source(templateName)
+}
+
 func (r *Renderer) RenderToFile(templateName string, data interface{}, outputPath string) error {
 	content, err := r.Render(templateName, data)
 	if err != nil {
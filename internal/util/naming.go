@@ -5,12 +5,23 @@ import (
 	"unicode"
 )
 
+var initialisms = map[string]bool{
+	"ACL": true, "API": true, "ASCII": true, "CPU": true, "CSS": true, "DNS": true,
+	"EOF": true, "GUID": true, "HTML": true, "HTTP": true, "HTTPS": true, "ID": true,
+	"IP": true, "JSON": true, "QPS": true, "RAM": true, "RPC": true, "SKU": true,
+	"SLA": true, "SMTP": true, "SQL": true, "SSH": true, "TCP": true, "TLS": true,
+	"TTL": true, "UDP": true, "UI": true, "UID": true, "URI": true, "URL": true,
+	"UTF8": true, "UUID": true, "VM": true, "XML": true, "XSRF": true, "XSS": true,
+}
+
+// ToSnakeCase keeps initialisms together: OwnerID becomes owner_id and HTTPServer http_server.
 func ToSnakeCase(s string) string {
 	var result strings.Builder
 
-	for i, r := range s {
+	runes := []rune(s)
+	for i, r := range runes {
 		if unicode.IsUpper(r) {
-			if i > 0 {
+			if i > 0 && (!unicode.IsUpper(runes[i-1]) || i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
 				result.WriteRune('_')
 			}
 			result.WriteRune(unicode.ToLower(r))
@@ -32,7 +43,9 @@ func ToPascalCase(s string) string {
 	var result strings.Builder
 
 	for _, part := range parts {
-		if len(part) > 0 {
+		if initialisms[strings.ToUpper(part)] {
+			result.WriteString(strings.ToUpper(part))
+		} else if len(part) > 0 {
 			result.WriteRune(unicode.ToUpper(rune(part[0])))
 			result.WriteString(part[1:])
 		}
@@ -41,12 +54,44 @@ func ToPascalCase(s string) string {
 	return result.String()
 }
 
+func ToPascalIdentifier(s string) string {
+	words := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var result strings.Builder
+	for _, word := range words {
+		runes := []rune(word)
+		result.WriteRune(unicode.ToUpper(runes[0]))
+		result.WriteString(string(runes[1:]))
+	}
+
+	return result.String()
+}
+
+// ToCamelCase lowers a leading initialism as a whole: UserID becomes userID, ID id and
+// HTTPServer httpServer.
 func ToCamelCase(s string) string {
-	pascal := ToPascalCase(s)
+	runes := []rune(ToPascalCase(s))
 
-	if len(pascal) == 0 {
-		return pascal
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	if n > 1 && n < len(runes) && unicode.IsLower(runes[n]) {
+		n--
 	}
+	for i := 0; i < n; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+
+	return string(runes)
+}
+
+func ToKebabCase(s string) string {
+	return strings.ReplaceAll(ToSnakeCase(s), "_", "-")
+}
 
-	return strings.ToLower(pascal[:1]) + pascal[1:]
+func ToRoute(s string) string {
+	return "/" + ToKebabCase(s)
 }
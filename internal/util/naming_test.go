@@ -0,0 +1,72 @@
+package util
+
+import "testing"
+
+func TestToSnakeCase(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "Email", want: "email"},
+		{input: "CreatedAt", want: "created_at"},
+		{input: "ID", want: "id"},
+		{input: "OwnerID", want: "owner_id"},
+		{input: "UserIDHash", want: "user_id_hash"},
+		{input: "HTTPServer", want: "http_server"},
+		{input: "APIKey", want: "api_key"},
+		{input: "Address2", want: "address2"},
+		{input: "userName", want: "user_name"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := ToSnakeCase(tt.input); got != tt.want {
+				t.Errorf("ToSnakeCase(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToPascalCase(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "email", want: "Email"},
+		{input: "created_at", want: "CreatedAt"},
+		{input: "id", want: "ID"},
+		{input: "user_id", want: "UserID"},
+		{input: "api_url", want: "APIURL"},
+		{input: "OwnerID", want: "OwnerID"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := ToPascalCase(tt.input); got != tt.want {
+				t.Errorf("ToPascalCase(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToCamelCase(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "Email", want: "email"},
+		{input: "ID", want: "id"},
+		{input: "UserID", want: "userID"},
+		{input: "user_id", want: "userID"},
+		{input: "HTTPServer", want: "httpServer"},
+		{input: "CreateUser", want: "createUser"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := ToCamelCase(tt.input); got != tt.want {
+				t.Errorf("ToCamelCase(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -64,8 +64,9 @@ func ValidateType(typeName string) error {
 	}
 
 	baseType := typeName
-	baseType = strings.TrimPrefix(baseType, "*")
-	baseType = strings.TrimPrefix(baseType, "[]")
+	for strings.HasPrefix(baseType, "*") || strings.HasPrefix(baseType, "[]") {
+		baseType = strings.TrimPrefix(strings.TrimPrefix(baseType, "*"), "[]")
+	}
 
 	if basicTypes[baseType] {
 		return nil
@@ -14,4 +14,5 @@ const (
 	ComponentTypeHandler    ComponentType = "handler"
 	ComponentTypeMock       ComponentType = "mock"
 	ComponentTypeTest       ComponentType = "test"
+	ComponentTypeMigration  ComponentType = "migration"
 )
@@ -1,21 +1,25 @@
 package models
 
 type Config struct {
-	Version    string     `yaml:"version"`
-	Paths      Paths      `yaml:"paths"`
-	Naming     Naming     `yaml:"naming"`
-	Templates  Templates  `yaml:"templates"`
-	Generation Generation `yaml:"generation"`
-	Imports    Imports    `yaml:"imports"`
+	Version       string        `yaml:"version"`
+	Paths         Paths         `yaml:"paths"`
+	Naming        Naming        `yaml:"naming"`
+	Templates     Templates     `yaml:"templates"`
+	Generation    Generation    `yaml:"generation"`
+	Imports       Imports       `yaml:"imports"`
+	Migrations    Migrations    `yaml:"migrations"`
+	Observability Observability `yaml:"observability"`
 }
 
 type Paths struct {
 	Domain     string `yaml:"domain"`
 	Repository string `yaml:"repository"`
+	InMemory   string `yaml:"inmemory"`
 	UseCase    string `yaml:"usecase"`
 	Handler    string `yaml:"handler"`
 	Mocks      string `yaml:"mocks"`
 	Tests      string `yaml:"tests"`
+	Migrations string `yaml:"migrations"`
 }
 
 type Naming struct {
@@ -28,11 +32,26 @@ type Templates struct {
 	Entity              string `yaml:"entity"`
 	RepositoryInterface string `yaml:"repository_interface"`
 	RepositoryImpl      string `yaml:"repository_impl"`
+	RepositoryImplMongo string `yaml:"repository_impl_mongodb"`
+	RepositoryInMemory  string `yaml:"repository_inmemory"`
+	InMemory            string `yaml:"inmemory"`
 	UseCase             string `yaml:"usecase"`
 	Handler             string `yaml:"handler"`
+	HandlerResponse     string `yaml:"handler_response"`
+	Validation          string `yaml:"validation"`
+	Audit               string `yaml:"audit"`
+	Errors              string `yaml:"errors"`
+	Pagination          string `yaml:"pagination"`
+	RepositoryErrors    string `yaml:"repository_errors"`
+	UseCaseErrors       string `yaml:"usecase_errors"`
+	Transaction         string `yaml:"transaction"`
+	TxManager           string `yaml:"tx_manager"`
+	TxManagerMock       string `yaml:"tx_manager_mock"`
+	Observability       string `yaml:"observability"`
 	Mock                string `yaml:"mock"`
 	TestEntity          string `yaml:"test_entity"`
 	TestRepository      string `yaml:"test_repository"`
+	TestInMemory        string `yaml:"test_inmemory"`
 	TestUseCase         string `yaml:"test_usecase"`
 }
 
@@ -42,8 +61,33 @@ type Generation struct {
 	SeparateInterfaces bool   `yaml:"separate_interfaces"`
 	UsePointers        bool   `yaml:"use_pointers"`
 	ErrorHandling      string `yaml:"error_handling"`
+	Validation         string `yaml:"validation"`
+	IDStrategy         string `yaml:"id_strategy"`
 }
 
+const (
+	ErrorHandlingWrap   = "wrap"
+	ErrorHandlingReturn = "return"
+	ErrorHandlingPanic  = "panic"
+)
+
+type Migrations struct {
+	Format string `yaml:"format"`
+}
+
+type Observability struct {
+	Logging string `yaml:"logging"`
+	Metrics string `yaml:"metrics"`
+	Tracing string `yaml:"tracing"`
+}
+
+const (
+	ObservabilityNone = "none"
+	LoggingSlog       = "slog"
+	MetricsPrometheus = "prometheus"
+	TracingOTel       = "otel"
+)
+
 type Imports struct {
 	Entity     []string `yaml:"entity"`
 	Repository []string `yaml:"repository"`
@@ -7,6 +7,22 @@ type EntityConfig struct {
 	AddValidation bool    `json:"add_validation"`
 	AddComments   bool    `json:"add_comments"`
 	JSONStyle     string  `json:"json_style"`
+
+	IDStrategy   IDStrategy `json:"id_strategy,omitempty"`
+	Key          []string   `json:"key,omitempty"`
+	UniqueKeys   [][]string `json:"unique_keys,omitempty"`
+	NoTimestamps bool       `json:"no_timestamps,omitempty"`
+	SoftDelete   bool       `json:"soft_delete,omitempty"`
+	Audit        bool       `json:"audit,omitempty"`
+	Versioned    bool       `json:"versioned,omitempty"`
+
+	Relations []Relation `json:"relations,omitempty"`
+
+	Existing       bool    `json:"existing"`
+	Reused         bool    `json:"reused,omitempty"`
+	PreviousFields []Field `json:"previous_fields,omitempty"`
+	// Previous is the entity as recorded in the manifest when it was last generated.
+	Previous *EntityConfig `json:"-"`
 }
 
 func (e *EntityConfig) GetName() string {
@@ -35,6 +51,87 @@ func (e *EntityConfig) HasUUIDField() bool {
 	return false
 }
 
+func (e *EntityConfig) PrimaryKey() PrimaryKey {
+	strategy := e.IDStrategy
+	if strategy == "" {
+		strategy = IDUUID
+	}
+	if strategy != IDComposite {
+		return PrimaryKey{Strategy: strategy, Fields: []Field{IDField(strategy.GoType())}}
+	}
+
+	key := PrimaryKey{Strategy: strategy}
+	for _, name := range e.Key {
+		for _, field := range e.Fields {
+			if field.Name == name || field.DBTag == name {
+				key.Fields = append(key.Fields, field)
+			}
+		}
+	}
+	return key
+}
+
+func (e *EntityConfig) UniqueKeyFields() [][]Field {
+	groups := make([][]Field, 0, len(e.UniqueKeys))
+	for _, names := range e.UniqueKeys {
+		var group []Field
+		for _, name := range names {
+			for _, field := range e.Fields {
+				if field.Name == name || field.DBTag == name {
+					group = append(group, field)
+				}
+			}
+		}
+		if len(group) == len(names) {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
+
+func (e *EntityConfig) ImplicitFields() []Field {
+	var fields []Field
+
+	if key := e.PrimaryKey(); !key.Composite() {
+		fields = append(fields, key.Fields...)
+	}
+	if !e.NoTimestamps {
+		fields = append(fields, TimestampFields()...)
+	}
+	if e.SoftDelete {
+		fields = append(fields, SoftDeleteField())
+	}
+	if e.Versioned {
+		fields = append(fields, VersionField())
+	}
+	if e.Audit {
+		fields = append(fields, AuditFields()...)
+	}
+
+	return fields
+}
+
+func (e *EntityConfig) Schema() Schema {
+	return Schema{
+		Table:      e.TableName,
+		Key:        e.PrimaryKey(),
+		Columns:    append(e.ImplicitFields(), e.Fields...),
+		Timestamps: !e.NoTimestamps,
+		SoftDelete: e.SoftDelete,
+		Audit:      e.Audit,
+		Versioned:  e.Versioned,
+	}
+}
+
+func (e *EntityConfig) GetRelation(name string) *Relation {
+	for i := range e.Relations {
+		if e.Relations[i].Name == name {
+			return &e.Relations[i]
+		}
+	}
+	return nil
+}
+
 func (e *EntityConfig) GetRequiredFields() []Field {
 	var required []Field
 	for _, field := range e.Fields {
@@ -12,12 +12,21 @@ type Field struct {
 	Required bool     `json:"required"`
 	Unique   bool     `json:"unique"`
 	Index    bool     `json:"index"`
+
+	Relation RelationKind `json:"relation,omitempty"`
+	Enum     []string     `json:"enum,omitempty"`
+
+	Rules []ValidationRule `json:"rules,omitempty"`
 }
 
 func (f *Field) ZeroValue() string {
+	if f.IsEnum() && !f.IsPointer() {
+		return `""`
+	}
+
 	switch f.Type {
 	case "string":
-		return ""
+		return `""`
 	case "int", "int8", "int16", "int32", "int64":
 		return "0"
 	case "uint", "uint8", "uint16", "uint32", "uint64":
@@ -44,8 +53,63 @@ func (f *Field) IsPointer() bool {
 	return strings.HasPrefix(f.Type, "*")
 }
 
+func (f *Field) IsNullable() bool {
+	switch {
+	case f.IsPointer(), strings.HasPrefix(f.Type, "[]"), strings.HasPrefix(f.Type, "map["):
+		return true
+	case strings.HasPrefix(f.Type, "sql.Null"):
+		return true
+	default:
+		return f.Type == "uuid.NullUUID" || f.Type == "json.RawMessage"
+	}
+}
+
 func (f *Field) BaseType() string {
 	t := strings.TrimPrefix(f.Type, "*")
 	t = strings.TrimPrefix(t, "[]")
 	return t
 }
+
+func (f *Field) ValueKind() string {
+	if f.IsEnum() {
+		return ""
+	}
+
+	switch t := strings.TrimPrefix(f.Type, "*"); t {
+	case "string":
+		return "string"
+	case "int", "int8", "int16", "int32", "int64":
+		return "int"
+	case "uint", "uint8", "uint16", "uint32", "uint64", "byte":
+		return "uint"
+	case "float32", "float64":
+		return "float"
+	default:
+		if strings.HasPrefix(t, "[]") || strings.HasPrefix(t, "map[") {
+			return "collection"
+		}
+		return ""
+	}
+}
+
+func (f *Field) IsEnum() bool {
+	return len(f.Enum) > 0
+}
+
+func (f *Field) ValidateTag() string {
+	var tags []string
+	for _, rule := range f.Rules {
+		if tag := rule.Tag(); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	switch {
+	case f.Required:
+		tags = append([]string{"required"}, tags...)
+	case len(tags) > 0:
+		tags = append([]string{"omitempty"}, tags...)
+	}
+
+	return strings.Join(tags, ",")
+}
@@ -0,0 +1,78 @@
+package models
+
+type IDStrategy string
+
+const (
+	IDUUID      IDStrategy = "uuid"
+	IDULID      IDStrategy = "ulid"
+	IDInt64     IDStrategy = "int64"
+	IDString    IDStrategy = "string"
+	IDComposite IDStrategy = "composite"
+)
+
+func ParseIDStrategy(name string) (IDStrategy, bool) {
+	switch name {
+	case "":
+		return IDUUID, true
+	case "autoincrement", "serial", "bigserial":
+		return IDInt64, true
+	}
+
+	switch IDStrategy(name) {
+	case IDUUID, IDULID, IDInt64, IDString, IDComposite:
+		return IDStrategy(name), true
+	default:
+		return "", false
+	}
+}
+
+func (s IDStrategy) GoType() string {
+	switch s {
+	case IDULID, IDString:
+		return "string"
+	case IDInt64:
+		return "int64"
+	case IDComposite:
+		return ""
+	default:
+		return "uuid.UUID"
+	}
+}
+
+type PrimaryKey struct {
+	Strategy IDStrategy `json:"strategy"`
+	Fields   []Field    `json:"fields"`
+}
+
+func IDField(typeName string) Field {
+	return Field{Name: "ID", Type: typeName, JSONTag: "id", DBTag: "id"}
+}
+
+func (k PrimaryKey) Composite() bool {
+	return k.Strategy == IDComposite
+}
+
+func (k PrimaryKey) AutoIncrement() bool {
+	return k.Strategy == IDInt64
+}
+
+func (k PrimaryKey) Type() string {
+	return k.Strategy.GoType()
+}
+
+func (k PrimaryKey) Columns() []string {
+	columns := make([]string, len(k.Fields))
+	for i, field := range k.Fields {
+		columns[i] = field.DBTag
+	}
+	return columns
+}
+
+func (k PrimaryKey) Contains(field Field) bool {
+	for _, key := range k.Fields {
+		if key.DBTag == field.DBTag {
+			return true
+		}
+	}
+	return false
+}
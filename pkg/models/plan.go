@@ -12,11 +12,18 @@ type GenerationPlan struct {
 }
 
 type HandlerConfig struct {
-	Name        string `json:"name"`
-	UseCase     string `json:"usecase"`
-	Route       string `json:"route"`
-	Method      string `json:"method"`
-	AddComments bool   `json:"add_comments"`
+	Name        string     `json:"name"`
+	UseCase     string     `json:"usecase"`
+	Route       string     `json:"route"`
+	Method      string     `json:"method"`
+	Endpoints   []Endpoint `json:"endpoints"`
+	AddComments bool       `json:"add_comments"`
+}
+
+type Endpoint struct {
+	UseCase string `json:"usecase"`
+	Method  string `json:"method"`
+	Path    string `json:"path"`
 }
 
 func (h *HandlerConfig) GetName() string {
@@ -38,6 +45,47 @@ func (p *GenerationPlan) ComponentCount() int {
 	return len(p.Entities) + len(p.Repositories) + len(p.UseCases) + len(p.Handlers)
 }
 
+func (p *GenerationPlan) HasAudit() bool {
+	for _, entity := range p.Entities {
+		if entity.Audit {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *GenerationPlan) HasVersioned() bool {
+	for _, entity := range p.Entities {
+		if entity.Versioned {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *GenerationPlan) HasCRUD() bool {
+	for _, uc := range p.UseCases {
+		if uc.CRUD != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *GenerationPlan) HasTransactions() bool {
+	for _, repo := range p.Repositories {
+		if repo.WithTransactions {
+			return true
+		}
+	}
+	for _, uc := range p.UseCases {
+		if uc.Transactional {
+			return true
+		}
+	}
+	return false
+}
+
 func (p *GenerationPlan) GetEntityByName(name string) *EntityConfig {
 	for i := range p.Entities {
 		if p.Entities[i].Name == name {
@@ -56,6 +104,15 @@ func (p *GenerationPlan) GetRepositoryByName(name string) *RepositoryConfig {
 	return nil
 }
 
+func (p *GenerationPlan) GetUseCaseByName(name string) *UseCaseConfig {
+	for i := range p.UseCases {
+		if p.UseCases[i].Name == name {
+			return &p.UseCases[i]
+		}
+	}
+	return nil
+}
+
 func (p *GenerationPlan) HasEntity(name string) bool {
 	return p.GetEntityByName(name) != nil
 }
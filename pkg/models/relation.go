@@ -0,0 +1,43 @@
+package models
+
+type RelationKind string
+
+const (
+	RelationBelongsTo  RelationKind = "belongs_to"
+	RelationHasMany    RelationKind = "has_many"
+	RelationManyToMany RelationKind = "many_to_many"
+)
+
+type Relation struct {
+	Name          string       `json:"name"`
+	Kind          RelationKind `json:"kind"`
+	Entity        string       `json:"entity"`
+	Table         string       `json:"table"`
+	ForeignKey    string       `json:"foreign_key"`
+	Nullable      bool         `json:"nullable,omitempty"`
+	JoinTable     string       `json:"join_table,omitempty"`
+	References    string       `json:"references,omitempty"`
+	Inverse       bool         `json:"inverse,omitempty"`
+	KeyType       string       `json:"key_type,omitempty"`
+	ReferenceType string       `json:"reference_type,omitempty"`
+	TargetFields  []Field      `json:"target_fields,omitempty"`
+}
+
+var RelationKinds = []RelationKind{RelationBelongsTo, RelationHasMany, RelationManyToMany}
+
+func ParseRelationKind(tag string) (RelationKind, bool) {
+	for _, kind := range RelationKinds {
+		if string(kind) == tag {
+			return kind, true
+		}
+	}
+	return "", false
+}
+
+func (r Relation) TargetSoftDelete() bool {
+	return HasColumn(r.TargetFields, "deleted_at")
+}
+
+func (r *Relation) KeyField() string {
+	return r.Name + "ID"
+}
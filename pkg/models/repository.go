@@ -8,6 +8,8 @@ type RepositoryConfig struct {
 	CustomMethods    []CustomMethod `json:"custom_methods"`
 	WithTransactions bool           `json:"with_transactions"`
 	AddComments      bool           `json:"add_comments"`
+	Versioned        bool           `json:"versioned,omitempty"`
+	SkipMigration    bool           `json:"skip_migration,omitempty"`
 	Fields           []Field        `json:"fields"`
 }
 
@@ -20,11 +22,13 @@ func (r *RepositoryConfig) GetType() ComponentType {
 }
 
 type CustomMethod struct {
-	Name    string        `json:"name"`
-	Comment string        `json:"comment"`
-	Params  []MethodParam `json:"params"`
-	Returns []string      `json:"returns"`
-	Body    string        `json:"body"`
+	Name        string        `json:"name"`
+	Comment     string        `json:"comment"`
+	Params      []MethodParam `json:"params"`
+	Returns     []string      `json:"returns"`
+	ResultNames []string      `json:"result_names,omitempty"`
+	Body        string        `json:"body"`
+	Relation    string        `json:"relation,omitempty"`
 }
 
 type MethodParam struct {
@@ -0,0 +1,111 @@
+package models
+
+type Schema struct {
+	Table      string
+	Key        PrimaryKey
+	Columns    []Field
+	Timestamps bool
+	SoftDelete bool
+	Audit      bool
+	Versioned  bool
+}
+
+func TimestampFields() []Field {
+	return []Field{
+		{Name: "CreatedAt", Type: "time.Time", JSONTag: "created_at", DBTag: "created_at"},
+		{Name: "UpdatedAt", Type: "time.Time", JSONTag: "updated_at", DBTag: "updated_at"},
+	}
+}
+
+func SoftDeleteField() Field {
+	return Field{Name: "DeletedAt", Type: "*time.Time", JSONTag: "deleted_at", DBTag: "deleted_at"}
+}
+
+func VersionField() Field {
+	return Field{Name: "Version", Type: "int64", JSONTag: "version", DBTag: "version"}
+}
+
+func AuditFields() []Field {
+	return []Field{
+		{Name: "CreatedBy", Type: "string", JSONTag: "created_by", DBTag: "created_by"},
+		{Name: "UpdatedBy", Type: "string", JSONTag: "updated_by", DBTag: "updated_by"},
+	}
+}
+
+func (s Schema) Inserted() []Field {
+	if !s.Key.AutoIncrement() {
+		return s.Columns
+	}
+
+	inserted := make([]Field, 0, len(s.Columns))
+	for _, field := range s.Columns {
+		if field.DBTag != "id" {
+			inserted = append(inserted, field)
+		}
+	}
+	return inserted
+}
+
+func (s Schema) Updated() []Field {
+	updated := make([]Field, 0, len(s.Columns))
+	for _, field := range s.Columns {
+		switch field.DBTag {
+		case "id", "created_at", "created_by", "deleted_at", "version":
+			continue
+		}
+		if !s.Key.Contains(field) {
+			updated = append(updated, field)
+		}
+	}
+	return updated
+}
+
+func (s Schema) DefaultSort() Field {
+	if s.Timestamps {
+		return TimestampFields()[0]
+	}
+	return s.Key.Fields[0]
+}
+
+func (s Schema) SortFields() []Field {
+	var sortable []Field
+	for _, field := range s.Columns {
+		if field.IsPointer() || HasColumn(sortable, field.DBTag) {
+			continue
+		}
+		if s.Key.Contains(field) || field.Index || field.Unique ||
+			field.DBTag == "created_at" || field.DBTag == "updated_at" {
+			sortable = append(sortable, field)
+		}
+	}
+	return sortable
+}
+
+func (s Schema) FilterFields() []Field {
+	var filters []Field
+	for _, field := range s.Columns {
+		if (field.Index || field.Unique) && !HasColumn(filters, field.DBTag) {
+			filters = append(filters, field)
+		}
+	}
+	return filters
+}
+
+func (s Schema) KeysetColumns(column string) []string {
+	columns := []string{column}
+	for _, key := range s.Key.Columns() {
+		if key != column {
+			columns = append(columns, key)
+		}
+	}
+	return columns
+}
+
+func HasColumn(fields []Field, column string) bool {
+	for _, field := range fields {
+		if field.DBTag == column {
+			return true
+		}
+	}
+	return false
+}
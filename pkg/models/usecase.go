@@ -1,17 +1,29 @@
 package models
 
 type UseCaseConfig struct {
-	Name         string       `json:"name"`
-	Description  string       `json:"description"`
-	Dependencies []Dependency `json:"dependencies"`
-	InputFields  []Field      `json:"input_fields"`
-	OutputFields []Field      `json:"output_fields"`
-	WithLogging  bool         `json:"with_logging"`
-	WithMetrics  bool         `json:"with_metrics"`
-	AddComments  bool         `json:"add_comments"`
-	Example      string       `json:"example"`
+	Name          string       `json:"name"`
+	Description   string       `json:"description"`
+	Dependencies  []Dependency `json:"dependencies"`
+	InputFields   []Field      `json:"input_fields"`
+	OutputFields  []Field      `json:"output_fields"`
+	WithLogging   bool         `json:"with_logging"`
+	WithMetrics   bool         `json:"with_metrics"`
+	Transactional bool         `json:"transactional"`
+	CRUD          string       `json:"crud,omitempty"`
+	AddComments   bool         `json:"add_comments"`
+	Example       string       `json:"example"`
 }
 
+const (
+	CRUDCreate = "Create"
+	CRUDGet    = "Get"
+	CRUDUpdate = "Update"
+	CRUDDelete = "Delete"
+	CRUDList   = "List"
+)
+
+var CRUDActions = []string{CRUDCreate, CRUDGet, CRUDUpdate, CRUDDelete, CRUDList}
+
 func (u *UseCaseConfig) GetName() string {
 	return u.Name
 }
@@ -0,0 +1,56 @@
+package models
+
+import "strings"
+
+type ValidationRuleKind string
+
+const (
+	RuleMin   ValidationRuleKind = "min"
+	RuleMax   ValidationRuleKind = "max"
+	RuleLen   ValidationRuleKind = "len"
+	RuleEmail ValidationRuleKind = "email"
+	RuleURL   ValidationRuleKind = "url"
+	RuleRegex ValidationRuleKind = "regex"
+	RuleOneOf ValidationRuleKind = "oneof"
+)
+
+const (
+	ValidationMethods = "methods"
+	ValidationTags    = "tags"
+	ValidationBoth    = "both"
+)
+
+type ValidationRule struct {
+	Kind  ValidationRuleKind `json:"kind"`
+	Param string             `json:"param,omitempty"`
+}
+
+var ValidationRuleKinds = []ValidationRuleKind{RuleMin, RuleMax, RuleLen, RuleEmail, RuleURL, RuleRegex, RuleOneOf}
+
+func ParseValidationRuleKind(name string) (ValidationRuleKind, bool) {
+	for _, kind := range ValidationRuleKinds {
+		if string(kind) == name {
+			return kind, true
+		}
+	}
+	return "", false
+}
+
+func (r ValidationRule) Values() []string {
+	return strings.FieldsFunc(r.Param, func(c rune) bool {
+		return c == '|' || c == ' '
+	})
+}
+
+func (r ValidationRule) Tag() string {
+	switch r.Kind {
+	case RuleRegex:
+		return ""
+	case RuleOneOf:
+		return string(r.Kind) + "=" + strings.Join(r.Values(), " ")
+	case RuleEmail, RuleURL:
+		return string(r.Kind)
+	default:
+		return string(r.Kind) + "=" + r.Param
+	}
+}